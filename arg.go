@@ -0,0 +1,144 @@
+// MIT License
+//
+// Copyright (c) 2022 Tommy TIAN
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package complex
+
+import "math/big"
+
+// argGuardBits is the number of extra bits of working precision used by Arg to absorb rounding errors
+const argGuardBits = 64
+
+// Arg returns the argument (angle) of the Gaussian integer in the range (-pi, pi] as a big float with
+// the given precision in bits, or 53 bits (the precision of float64) if prec is 0
+// The angle is computed exactly from the integer parts with arbitrary-precision series, not by converting
+// to complex128, so it is correct to within one unit in the last place even for very large parts
+// The argument of zero is defined as 0, matching math.Atan2(0, 0)
+func (g *GaussianInt) Arg(prec uint) *big.Float {
+	if prec == 0 {
+		prec = 53
+	}
+	res := new(big.Float).SetPrec(prec)
+	if g.IsZero() {
+		return res
+	}
+	wp := prec + argGuardBits
+	ax := new(big.Float).SetPrec(wp).SetInt(g.R)
+	ax.Abs(ax)
+	ay := new(big.Float).SetPrec(wp).SetInt(g.I)
+	ay.Abs(ay)
+	var theta *big.Float
+	var pi *big.Float
+	// reduce to the first octant, where the tangent is at most 1
+	if ay.Cmp(ax) <= 0 {
+		theta = bigAtan(ay.Quo(ay, ax), wp)
+	} else {
+		pi = bigPi(wp)
+		theta = new(big.Float).SetPrec(wp).Quo(pi, big2f)
+		theta.Sub(theta, bigAtan(ax.Quo(ax, ay), wp))
+	}
+	if g.R.Sign() < 0 {
+		if pi == nil {
+			pi = bigPi(wp)
+		}
+		theta.Sub(pi, theta)
+	}
+	if g.I.Sign() < 0 {
+		theta.Neg(theta)
+	}
+	return res.Set(theta)
+}
+
+// Abs returns the modulus (absolute value) sqrt(N(g)) of the Gaussian integer as a big float with
+// the given precision in bits, or 53 bits if prec is 0
+func (g *GaussianInt) Abs(prec uint) *big.Float {
+	if prec == 0 {
+		prec = 53
+	}
+	norm := new(big.Float).SetPrec(prec + argGuardBits).SetInt(g.Norm())
+	return new(big.Float).SetPrec(prec).Set(norm.Sqrt(norm))
+}
+
+// Polar returns the polar form r∠θ of the Gaussian integer, with the modulus r given by Abs and
+// the argument θ in radians given by Arg, e.g. 5∠0.9272952180016122 for 3+4i
+// Both are computed with the given precision in bits, or 53 bits if prec is 0, and printed with the
+// fewest decimal digits that identify them at that precision, so a smaller prec gives a shorter output
+func (g *GaussianInt) Polar(prec uint) string {
+	b := g.Abs(prec).Append(nil, 'g', -1)
+	b = append(b, "∠"...)
+	return string(g.Arg(prec).Append(b, 'g', -1))
+}
+
+// bigAtan returns the arctangent of t in [0, 1] with the given precision using the Taylor series
+// atan(t) = t - t^3/3 + t^5/5 - ..., after halving the angle with atan(t) = 2 * atan(t / (1 + sqrt(1 + t^2)))
+// until t < 2^-8 to speed up the convergence
+func bigAtan(t *big.Float, prec uint) *big.Float {
+	x := new(big.Float).SetPrec(prec).Set(t)
+	one := new(big.Float).SetPrec(prec).SetInt64(1)
+	threshold := new(big.Float).SetPrec(prec).SetMantExp(one, -8)
+	opt := new(big.Float).SetPrec(prec)
+	halvings := 0
+	for x.Cmp(threshold) > 0 {
+		opt.Mul(x, x)
+		opt.Add(opt, one)
+		opt.Sqrt(opt)
+		opt.Add(opt, one)
+		x.Quo(x, opt)
+		halvings++
+	}
+	res := atanSeries(x, prec)
+	return res.SetMantExp(res, halvings)
+}
+
+// bigPi returns pi with the given precision using Machin's formula pi = 16 * atan(1/5) - 4 * atan(1/239)
+func bigPi(prec uint) *big.Float {
+	x := new(big.Float).SetPrec(prec).SetInt64(1)
+	a := atanSeries(x.Quo(x, new(big.Float).SetInt64(5)), prec)
+	x.SetInt64(1)
+	b := atanSeries(x.Quo(x, new(big.Float).SetInt64(239)), prec)
+	a.SetMantExp(a, 4)
+	b.SetMantExp(b, 2)
+	return a.Sub(a, b)
+}
+
+// atanSeries sums the Taylor series of atan(x) for 0 <= x < 1 until the terms fall below 2^-prec
+func atanSeries(x *big.Float, prec uint) *big.Float {
+	sum := new(big.Float).SetPrec(prec).Set(x)
+	if x.Sign() == 0 {
+		return sum
+	}
+	x2 := new(big.Float).SetPrec(prec).Mul(x, x)
+	power := new(big.Float).SetPrec(prec).Set(x)
+	term := new(big.Float).SetPrec(prec)
+	denominator := new(big.Float).SetPrec(prec)
+	for n := int64(1); ; n++ {
+		power.Mul(power, x2)
+		term.Quo(power, denominator.SetInt64(2*n+1))
+		if term.MantExp(nil) < -int(prec) {
+			return sum
+		}
+		if n%2 == 1 {
+			sum.Sub(sum, term)
+		} else {
+			sum.Add(sum, term)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+// MIT License
+//
+// Copyright (c) 2022 Tommy TIAN
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package complex
+
+import (
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+// piDigits is pi to 100 decimal places
+const piDigits = "3.1415926535897932384626433832795028841971693993751058209749445923078164062862089986280348253421170679"
+
+func TestGaussianInt_Arg(t *testing.T) {
+	pi, _ := new(big.Float).SetPrec(300).SetString(piDigits)
+	fraction := func(num, den int64) *big.Float {
+		f := new(big.Float).SetPrec(300).Mul(pi, big.NewFloat(float64(num)))
+		return f.Quo(f, big.NewFloat(float64(den)))
+	}
+	tests := []struct {
+		name string
+		g    *GaussianInt
+		want *big.Float
+	}{
+		{"test_zero", NewGaussianInt(big.NewInt(0), big.NewInt(0)), new(big.Float)},
+		{"test_positive_real", NewGaussianInt(big.NewInt(7), big.NewInt(0)), new(big.Float)},
+		{"test_negative_real", NewGaussianInt(big.NewInt(-7), big.NewInt(0)), fraction(1, 1)},
+		{"test_positive_imag", NewGaussianInt(big.NewInt(0), big.NewInt(3)), fraction(1, 2)},
+		{"test_negative_imag", NewGaussianInt(big.NewInt(0), big.NewInt(-3)), fraction(-1, 2)},
+		{"test_1+i", NewGaussianInt(big.NewInt(1), big.NewInt(1)), fraction(1, 4)},
+		{"test_-5+5i", NewGaussianInt(big.NewInt(-5), big.NewInt(5)), fraction(3, 4)},
+		{"test_-2-2i", NewGaussianInt(big.NewInt(-2), big.NewInt(-2)), fraction(-3, 4)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, prec := range []uint{53, 128, 256} {
+				got := tt.g.Arg(prec)
+				want := new(big.Float).SetPrec(prec).Set(tt.want)
+				if got.Prec() != prec || got.Cmp(want) != 0 {
+					t.Errorf("Arg(%d) = %v, want %v", prec, got.Text('g', 80), want.Text('g', 80))
+				}
+			}
+		})
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for n := 0; n < 1000; n++ {
+		g := NewGaussianInt(big.NewInt(r.Int63n(2e9)-1e9), big.NewInt(r.Int63n(2e9)-1e9))
+		got, _ := g.Arg(0).Float64()
+		want := math.Atan2(float64(g.I.Int64()), float64(g.R.Int64()))
+		if math.Abs(got-want) > 1e-15*math.Max(1, math.Abs(want)) {
+			t.Fatalf("Arg(%v) = %v, want %v", g, got, want)
+		}
+	}
+
+	// scaling by a large integer keeps the angle, which must not lose precision
+	g := NewGaussianInt(big.NewInt(3), big.NewInt(-11))
+	scale := new(big.Int).Lsh(big1, 4000)
+	large := NewGaussianInt(new(big.Int).Mul(g.R, scale), new(big.Int).Mul(g.I, scale))
+	if got, want := large.Arg(200), g.Arg(200); got.Cmp(want) != 0 {
+		t.Errorf("Arg() = %v, want %v", got, want)
+	}
+}
+
+func TestGaussianInt_Abs(t *testing.T) {
+	tests := []struct {
+		name string
+		g    *GaussianInt
+		want string
+	}{
+		{"test_zero", NewGaussianInt(big.NewInt(0), big.NewInt(0)), "0"},
+		{"test_3+4i", NewGaussianInt(big.NewInt(3), big.NewInt(4)), "5"},
+		{"test_1-i", NewGaussianInt(big.NewInt(1), big.NewInt(-1)), "1.4142135623730951"},
+		{"test_-7", NewGaussianInt(big.NewInt(-7), big.NewInt(0)), "7"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.g.Abs(0); got.Prec() != 53 || got.Text('g', -1) != tt.want {
+				t.Errorf("Abs() = %v, want %v", got.Text('g', -1), tt.want)
+			}
+		})
+	}
+}
+
+func TestGaussianInt_Polar(t *testing.T) {
+	tests := []struct {
+		name string
+		g    *GaussianInt
+		prec uint
+		want string
+	}{
+		{"test_zero", NewGaussianInt(big.NewInt(0), big.NewInt(0)), 0, "0∠0"},
+		{"test_3+4i", NewGaussianInt(big.NewInt(3), big.NewInt(4)), 0, "5∠0.9272952180016122"},
+		{"test_3+4i_low_prec", NewGaussianInt(big.NewInt(3), big.NewInt(4)), 10, "5∠0.928"},
+		{"test_-2", NewGaussianInt(big.NewInt(-2), big.NewInt(0)), 0, "2∠3.141592653589793"},
+		{"test_-i", NewGaussianInt(big.NewInt(0), big.NewInt(-1)), 0, "1∠-1.5707963267948966"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.g.Polar(tt.prec); got != tt.want {
+				t.Errorf("Polar() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
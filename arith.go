@@ -0,0 +1,63 @@
+// MIT License
+//
+// Copyright (c) 2022 Tommy TIAN
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package complex
+
+// AddG returns a new Gaussian integer equal to a + b without modifying the inputs
+func AddG(a, b *GaussianInt) *GaussianInt {
+	return new(GaussianInt).Add(a, b)
+}
+
+// SubG returns a new Gaussian integer equal to a - b without modifying the inputs
+func SubG(a, b *GaussianInt) *GaussianInt {
+	return new(GaussianInt).Sub(a, b)
+}
+
+// MulG returns a new Gaussian integer equal to a * b without modifying the inputs
+func MulG(a, b *GaussianInt) *GaussianInt {
+	return new(GaussianInt).Prod(a, b)
+}
+
+// ConjG returns a new Gaussian integer equal to the conjugate of a without modifying the input
+func ConjG(a *GaussianInt) *GaussianInt {
+	return new(GaussianInt).Conj(a)
+}
+
+// AddH returns a new Hurwitz integer equal to a + b without modifying the inputs
+func AddH(a, b *HurwitzInt) *HurwitzInt {
+	return new(HurwitzInt).Add(a, b)
+}
+
+// SubH returns a new Hurwitz integer equal to a - b without modifying the inputs
+func SubH(a, b *HurwitzInt) *HurwitzInt {
+	return new(HurwitzInt).Sub(a, b)
+}
+
+// MulH returns a new Hurwitz integer equal to the Hamilton product a * b without modifying the inputs
+func MulH(a, b *HurwitzInt) *HurwitzInt {
+	return new(HurwitzInt).Prod(a, b)
+}
+
+// ConjH returns a new Hurwitz integer equal to the conjugate of a without modifying the input
+func ConjH(a *HurwitzInt) *HurwitzInt {
+	return new(HurwitzInt).Conj(a)
+}
@@ -0,0 +1,207 @@
+// MIT License
+//
+// Copyright (c) 2022 Tommy TIAN
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package complex
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestMulG(t *testing.T) {
+	a := NewGaussianInt(big.NewInt(1), big.NewInt(2))
+	b := NewGaussianInt(big.NewInt(3), big.NewInt(-1))
+	c := NewGaussianInt(big.NewInt(0), big.NewInt(1))
+	// ((1+2i) + (3-i)) * i = (4+i) * i = -1+4i
+	want := NewGaussianInt(big.NewInt(-1), big.NewInt(4))
+	if got := MulG(AddG(a, b), c); !got.Equals(want) {
+		t.Errorf("MulG(AddG(a, b), c) = %v, want %v", got, want)
+	}
+	if !a.Equals(NewGaussianInt(big.NewInt(1), big.NewInt(2))) || !b.Equals(NewGaussianInt(big.NewInt(3), big.NewInt(-1))) {
+		t.Errorf("inputs modified to %v and %v", a, b)
+	}
+}
+
+func TestMulH(t *testing.T) {
+	a := HurwitzI()
+	b := HurwitzJ()
+	// (i - j) * (i * j) = (i - j) * k = -j - i
+	want := NewHurwitzInt(big.NewInt(0), big.NewInt(-1), big.NewInt(-1), big.NewInt(0), false)
+	if got := MulH(SubH(a, b), MulH(a, b)); !got.Equals(want) {
+		t.Errorf("MulH(SubH(a, b), MulH(a, b)) = %v, want %v", got, want)
+	}
+	if !a.Equals(HurwitzI()) || !b.Equals(HurwitzJ()) {
+		t.Errorf("inputs modified to %v and %v", a, b)
+	}
+}
+
+func TestAddG(t *testing.T) {
+	a := NewGaussianInt(big.NewInt(1), big.NewInt(2))
+	tests := []struct {
+		name string
+		a    *GaussianInt
+		b    *GaussianInt
+		want *GaussianInt
+	}{
+		{"test_(1+2i)+(3-i)", a, NewGaussianInt(big.NewInt(3), big.NewInt(-1)), NewGaussianInt(big.NewInt(4), big.NewInt(1))},
+		{"test_(1+2i)+0", a, NewGaussianInt(big.NewInt(0), big.NewInt(0)), NewGaussianInt(big.NewInt(1), big.NewInt(2))},
+		{"test_aliased", a, a, NewGaussianInt(big.NewInt(2), big.NewInt(4))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			origA, origB := tt.a.Copy(), tt.b.Copy()
+			got := AddG(tt.a, tt.b)
+			if !got.Equals(tt.want) {
+				t.Errorf("AddG() = %v, want %v", got, tt.want)
+			}
+			if got == tt.a || got == tt.b || !tt.a.Equals(origA) || !tt.b.Equals(origB) {
+				t.Errorf("AddG() modified the inputs to %v and %v", tt.a, tt.b)
+			}
+		})
+	}
+}
+
+func TestSubG(t *testing.T) {
+	a := NewGaussianInt(big.NewInt(1), big.NewInt(2))
+	tests := []struct {
+		name string
+		a    *GaussianInt
+		b    *GaussianInt
+		want *GaussianInt
+	}{
+		{"test_(1+2i)-(3-i)", a, NewGaussianInt(big.NewInt(3), big.NewInt(-1)), NewGaussianInt(big.NewInt(-2), big.NewInt(3))},
+		{"test_0-(1+2i)", NewGaussianInt(big.NewInt(0), big.NewInt(0)), a, NewGaussianInt(big.NewInt(-1), big.NewInt(-2))},
+		{"test_aliased", a, a, NewGaussianInt(big.NewInt(0), big.NewInt(0))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			origA, origB := tt.a.Copy(), tt.b.Copy()
+			got := SubG(tt.a, tt.b)
+			if !got.Equals(tt.want) {
+				t.Errorf("SubG() = %v, want %v", got, tt.want)
+			}
+			if got == tt.a || got == tt.b || !tt.a.Equals(origA) || !tt.b.Equals(origB) {
+				t.Errorf("SubG() modified the inputs to %v and %v", tt.a, tt.b)
+			}
+		})
+	}
+}
+
+func TestConjG(t *testing.T) {
+	tests := []struct {
+		name string
+		a    *GaussianInt
+		want *GaussianInt
+	}{
+		{"test_3-4i", NewGaussianInt(big.NewInt(3), big.NewInt(-4)), NewGaussianInt(big.NewInt(3), big.NewInt(4))},
+		{"test_real", NewGaussianInt(big.NewInt(-5), big.NewInt(0)), NewGaussianInt(big.NewInt(-5), big.NewInt(0))},
+		{"test_imaginary", NewGaussianInt(big.NewInt(0), big.NewInt(7)), NewGaussianInt(big.NewInt(0), big.NewInt(-7))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orig := tt.a.Copy()
+			got := ConjG(tt.a)
+			if !got.Equals(tt.want) {
+				t.Errorf("ConjG() = %v, want %v", got, tt.want)
+			}
+			if got == tt.a || !tt.a.Equals(orig) {
+				t.Errorf("ConjG() modified the input to %v", tt.a)
+			}
+		})
+	}
+}
+
+func TestAddH(t *testing.T) {
+	a := NewHurwitzInt(big.NewInt(1), big.NewInt(-1), big.NewInt(1), big.NewInt(1), true)
+	tests := []struct {
+		name string
+		a    *HurwitzInt
+		b    *HurwitzInt
+		want *HurwitzInt
+	}{
+		{"test_i+j", HurwitzI(), HurwitzJ(), NewHurwitzInt(big.NewInt(0), big.NewInt(1), big.NewInt(1), big.NewInt(0), false)},
+		{"test_half+half", a, NewHurwitzInt(big.NewInt(1), big.NewInt(1), big.NewInt(-1), big.NewInt(1), true), NewHurwitzInt(big.NewInt(1), big.NewInt(0), big.NewInt(0), big.NewInt(1), false)},
+		{"test_aliased", a, a, NewHurwitzInt(big.NewInt(1), big.NewInt(-1), big.NewInt(1), big.NewInt(1), false)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			origA, origB := tt.a.Copy(), tt.b.Copy()
+			got := AddH(tt.a, tt.b)
+			if !got.Equals(tt.want) {
+				t.Errorf("AddH() = %v, want %v", got, tt.want)
+			}
+			if got == tt.a || got == tt.b || !tt.a.Equals(origA) || !tt.b.Equals(origB) {
+				t.Errorf("AddH() modified the inputs to %v and %v", tt.a, tt.b)
+			}
+		})
+	}
+}
+
+func TestSubH(t *testing.T) {
+	a := NewHurwitzInt(big.NewInt(1), big.NewInt(-1), big.NewInt(1), big.NewInt(1), true)
+	tests := []struct {
+		name string
+		a    *HurwitzInt
+		b    *HurwitzInt
+		want *HurwitzInt
+	}{
+		{"test_i-j", HurwitzI(), HurwitzJ(), NewHurwitzInt(big.NewInt(0), big.NewInt(1), big.NewInt(-1), big.NewInt(0), false)},
+		{"test_half-1", a, HurwitzOne(), NewHurwitzInt(big.NewInt(-1), big.NewInt(-1), big.NewInt(1), big.NewInt(1), true)},
+		{"test_aliased", a, a, HurwitzZero()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			origA, origB := tt.a.Copy(), tt.b.Copy()
+			got := SubH(tt.a, tt.b)
+			if !got.Equals(tt.want) {
+				t.Errorf("SubH() = %v, want %v", got, tt.want)
+			}
+			if got == tt.a || got == tt.b || !tt.a.Equals(origA) || !tt.b.Equals(origB) {
+				t.Errorf("SubH() modified the inputs to %v and %v", tt.a, tt.b)
+			}
+		})
+	}
+}
+
+func TestConjH(t *testing.T) {
+	tests := []struct {
+		name string
+		a    *HurwitzInt
+		want *HurwitzInt
+	}{
+		{"test_1+2i-3j+4k", NewHurwitzInt(big.NewInt(1), big.NewInt(2), big.NewInt(-3), big.NewInt(4), false), NewHurwitzInt(big.NewInt(1), big.NewInt(-2), big.NewInt(3), big.NewInt(-4), false)},
+		{"test_half", NewHurwitzInt(big.NewInt(1), big.NewInt(-1), big.NewInt(1), big.NewInt(1), true), NewHurwitzInt(big.NewInt(1), big.NewInt(1), big.NewInt(-1), big.NewInt(-1), true)},
+		{"test_zero_value", new(HurwitzInt), HurwitzZero()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orig := tt.a.Copy()
+			got := ConjH(tt.a)
+			if !got.Equals(tt.want) {
+				t.Errorf("ConjH() = %v, want %v", got, tt.want)
+			}
+			if got == tt.a || !tt.a.Equals(orig) {
+				t.Errorf("ConjH() modified the input to %v", tt.a)
+			}
+		})
+	}
+}
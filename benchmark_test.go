@@ -0,0 +1,303 @@
+// MIT License
+//
+// Copyright (c) 2022 Tommy TIAN
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package complex
+
+import (
+	"fmt"
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+var benchBitSizes = []int{64, 512, 4096}
+
+func benchRandInt(r *rand.Rand, bits int) *big.Int {
+	x := new(big.Int).Rand(r, new(big.Int).Lsh(big1, uint(bits)))
+	if r.Intn(2) == 0 {
+		x.Neg(x)
+	}
+	return x
+}
+
+func benchGaussianInt(r *rand.Rand, bits int) *GaussianInt {
+	return NewGaussianInt(benchRandInt(r, bits), benchRandInt(r, bits))
+}
+
+func benchHurwitzInt(r *rand.Rand, bits int) *HurwitzInt {
+	return NewHurwitzInt(benchRandInt(r, bits), benchRandInt(r, bits), benchRandInt(r, bits), benchRandInt(r, bits), false)
+}
+
+func BenchmarkGaussianInt_Prod(b *testing.B) {
+	for _, bits := range benchBitSizes {
+		r := rand.New(rand.NewSource(1))
+		x, y := benchGaussianInt(r, bits), benchGaussianInt(r, bits)
+		b.Run(fmt.Sprintf("%dbit", bits), func(b *testing.B) {
+			b.ReportAllocs()
+			g := new(GaussianInt)
+			for n := 0; n < b.N; n++ {
+				g.Prod(x, y)
+			}
+		})
+	}
+}
+
+func BenchmarkGaussianInt_Norm(b *testing.B) {
+	for _, bits := range benchBitSizes {
+		r := rand.New(rand.NewSource(1))
+		x := benchGaussianInt(r, bits)
+		b.Run(fmt.Sprintf("%dbit", bits), func(b *testing.B) {
+			b.ReportAllocs()
+			for n := 0; n < b.N; n++ {
+				x.Norm()
+			}
+		})
+	}
+}
+
+func BenchmarkGaussianInt_Div(b *testing.B) {
+	for _, bits := range benchBitSizes {
+		r := rand.New(rand.NewSource(1))
+		x, y := benchGaussianInt(r, 2*bits), benchGaussianInt(r, bits)
+		b.Run(fmt.Sprintf("%dbit", bits), func(b *testing.B) {
+			b.ReportAllocs()
+			g := new(GaussianInt)
+			for n := 0; n < b.N; n++ {
+				g.Div(x, y)
+			}
+		})
+	}
+}
+
+func BenchmarkGaussianInt_GCD(b *testing.B) {
+	for _, bits := range benchBitSizes {
+		r := rand.New(rand.NewSource(1))
+		x, y := benchGaussianInt(r, bits), benchGaussianInt(r, bits)
+		b.Run(fmt.Sprintf("%dbit", bits), func(b *testing.B) {
+			b.ReportAllocs()
+			g := new(GaussianInt)
+			for n := 0; n < b.N; n++ {
+				g.GCD(x, y)
+			}
+		})
+	}
+}
+
+func BenchmarkHurwitzInt_Prod(b *testing.B) {
+	for _, bits := range benchBitSizes {
+		r := rand.New(rand.NewSource(1))
+		x, y := benchHurwitzInt(r, bits), benchHurwitzInt(r, bits)
+		b.Run(fmt.Sprintf("%dbit", bits), func(b *testing.B) {
+			b.ReportAllocs()
+			h := new(HurwitzInt)
+			for n := 0; n < b.N; n++ {
+				h.Prod(x, y)
+			}
+		})
+	}
+}
+
+func BenchmarkHurwitzInt_Norm(b *testing.B) {
+	for _, bits := range benchBitSizes {
+		r := rand.New(rand.NewSource(1))
+		x := benchHurwitzInt(r, bits)
+		b.Run(fmt.Sprintf("%dbit", bits), func(b *testing.B) {
+			b.ReportAllocs()
+			for n := 0; n < b.N; n++ {
+				x.Norm()
+			}
+		})
+	}
+}
+
+func BenchmarkHurwitzInt_Div(b *testing.B) {
+	for _, bits := range benchBitSizes {
+		r := rand.New(rand.NewSource(1))
+		x, y := benchHurwitzInt(r, 2*bits), benchHurwitzInt(r, bits)
+		b.Run(fmt.Sprintf("%dbit", bits), func(b *testing.B) {
+			b.ReportAllocs()
+			h := new(HurwitzInt)
+			for n := 0; n < b.N; n++ {
+				h.Div(x, y)
+			}
+		})
+	}
+}
+
+func BenchmarkHurwitzInt_GCRD(b *testing.B) {
+	for _, bits := range benchBitSizes {
+		r := rand.New(rand.NewSource(1))
+		x, y := benchHurwitzInt(r, bits), benchHurwitzInt(r, bits)
+		b.Run(fmt.Sprintf("%dbit", bits), func(b *testing.B) {
+			b.ReportAllocs()
+			h := new(HurwitzInt)
+			for n := 0; n < b.N; n++ {
+				h.GCRD(x, y)
+			}
+		})
+	}
+}
+
+func BenchmarkHurwitzInt_Zero(b *testing.B) {
+	b.ReportAllocs()
+	h := HurwitzZero()
+	for n := 0; n < b.N; n++ {
+		h.Zero()
+	}
+}
+
+func BenchmarkHurwitzInt_CmpNorm(b *testing.B) {
+	for _, bits := range benchBitSizes {
+		r := rand.New(rand.NewSource(1))
+		x, y := benchHurwitzInt(r, bits), benchHurwitzInt(r, bits)
+		b.Run(fmt.Sprintf("%dbit", bits), func(b *testing.B) {
+			b.ReportAllocs()
+			for n := 0; n < b.N; n++ {
+				x.CmpNorm(y)
+			}
+		})
+	}
+}
+
+// benchOnePlusIPower returns a random Gaussian integer with the given number of bits multiplied by (1+i)^bits
+func benchOnePlusIPower(r *rand.Rand, bits int) *GaussianInt {
+	g := benchGaussianInt(r, bits)
+	g.R.Lsh(g.R, uint(bits/2))
+	g.I.Lsh(g.I, uint(bits/2))
+	return g
+}
+
+func BenchmarkGaussianInt_BinaryGCD(b *testing.B) {
+	for _, bits := range benchBitSizes {
+		r := rand.New(rand.NewSource(1))
+		x, y := benchGaussianInt(r, bits), benchGaussianInt(r, bits)
+		px, py := benchOnePlusIPower(r, bits), benchOnePlusIPower(r, bits)
+		b.Run(fmt.Sprintf("%dbit", bits), func(b *testing.B) {
+			b.ReportAllocs()
+			g := new(GaussianInt)
+			for n := 0; n < b.N; n++ {
+				g.BinaryGCD(x, y)
+			}
+		})
+		b.Run(fmt.Sprintf("%dbit_(1+i)-power", bits), func(b *testing.B) {
+			b.ReportAllocs()
+			g := new(GaussianInt)
+			for n := 0; n < b.N; n++ {
+				g.BinaryGCD(px, py)
+			}
+		})
+		b.Run(fmt.Sprintf("%dbit_(1+i)-power_GCD", bits), func(b *testing.B) {
+			b.ReportAllocs()
+			g := new(GaussianInt)
+			for n := 0; n < b.N; n++ {
+				g.GCD(px, py)
+			}
+		})
+	}
+}
+
+func BenchmarkGaussianInt_LehmerGCD(b *testing.B) {
+	for _, bits := range []int{512, 2048} {
+		r := rand.New(rand.NewSource(1))
+		x, y := benchGaussianInt(r, bits), benchGaussianInt(r, bits)
+		b.Run(fmt.Sprintf("%dbit", bits), func(b *testing.B) {
+			b.ReportAllocs()
+			g := new(GaussianInt)
+			for n := 0; n < b.N; n++ {
+				g.LehmerGCD(x, y)
+			}
+		})
+		b.Run(fmt.Sprintf("%dbit_GCD", bits), func(b *testing.B) {
+			b.ReportAllocs()
+			g := new(GaussianInt)
+			for n := 0; n < b.N; n++ {
+				g.GCD(x, y)
+			}
+		})
+	}
+}
+
+func BenchmarkSortByNorm(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	xs := make([]*GaussianInt, 10000)
+	for i := range xs {
+		xs[i] = benchGaussianInt(r, 512)
+	}
+	buf := make([]*GaussianInt, len(xs))
+	b.Run("SortByNorm", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			copy(buf, xs)
+			SortByNorm(buf)
+		}
+	})
+	b.Run("SortByPrecomputedNorm", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			copy(buf, xs)
+			SortByPrecomputedNorm(buf, PrecomputeNorms(buf))
+		}
+	})
+}
+
+func BenchmarkAcquireGaussianInt(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	x, y := benchGaussianInt(r, 512), benchGaussianInt(r, 512)
+	b.Run("new", func(b *testing.B) {
+		b.ReportAllocs()
+		acc := new(GaussianInt)
+		for n := 0; n < b.N; n++ {
+			prod := new(GaussianInt).Prod(x, y)
+			acc.Add(acc, prod)
+		}
+	})
+	b.Run("AcquireRelease", func(b *testing.B) {
+		b.ReportAllocs()
+		acc := new(GaussianInt)
+		for n := 0; n < b.N; n++ {
+			prod := AcquireGaussianInt().Prod(x, y)
+			acc.Add(acc, prod)
+			prod.Release()
+		}
+	})
+}
+
+func BenchmarkAcquireHurwitzInt(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	x := NewHurwitzInt(benchRandInt(r, 512), benchRandInt(r, 512), benchRandInt(r, 512), benchRandInt(r, 512), false)
+	y := NewHurwitzInt(benchRandInt(r, 512), benchRandInt(r, 512), benchRandInt(r, 512), benchRandInt(r, 512), false)
+	b.Run("new", func(b *testing.B) {
+		b.ReportAllocs()
+		acc := new(HurwitzInt)
+		for n := 0; n < b.N; n++ {
+			prod := new(HurwitzInt).Prod(x, y)
+			acc.Add(acc, prod)
+		}
+	})
+	b.Run("AcquireRelease", func(b *testing.B) {
+		b.ReportAllocs()
+		acc := new(HurwitzInt)
+		for n := 0; n < b.N; n++ {
+			prod := AcquireHurwitzInt().Prod(x, y)
+			acc.Add(acc, prod)
+			prod.Release()
+		}
+	})
+}
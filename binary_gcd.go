@@ -0,0 +1,140 @@
+// MIT License
+//
+// Copyright (c) 2022 Tommy TIAN
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package complex
+
+import "math/big"
+
+// BinaryGCD calculates the greatest common divisor of two Gaussian integers with the binary GCD algorithm,
+// the analog of Stein's algorithm with the Gaussian prime 1+i in place of 2
+// The common factors of 1+i are counted first, then the odd parts are reduced by replacing the operand
+// with the larger norm by its difference with a unit multiple of the other operand, chosen to be divisible
+// by (1+i)^3, so that each step at least halves the larger norm without any division
+// Like GCD, the canonical associate given by Normalize is chosen as the result,
+// which is stored in the Gaussian integer that calls the method and returned
+// It uses only additions and shifts besides the norm comparisons, and in BenchmarkGaussianInt_BinaryGCD it is
+// faster than GCD from 64 bits on, with the gap widening for larger operands and many factors of 1+i
+func (g *GaussianInt) BinaryGCD(a, b *GaussianInt) *GaussianInt {
+	x := giPool.Get().(*GaussianInt).Set(a)
+	defer giPool.Put(x)
+	y := giPool.Get().(*GaussianInt).Set(b)
+	defer giPool.Put(y)
+	if x.IsZero() || y.IsZero() {
+		if x.IsZero() {
+			x, y = y, x
+		}
+		g.Normalize(x)
+		return new(GaussianInt).Set(g)
+	}
+	shift := uint(0)
+	for isOnePlusIMultiple(x) && isOnePlusIMultiple(y) {
+		divOnePlusI(x)
+		divOnePlusI(y)
+		shift++
+	}
+	stripOnePlusI(x)
+	stripOnePlusI(y)
+	for {
+		if x.CmpNorm(y) < 0 {
+			x, y = y, x
+		}
+		subUnitMultiple(x, y)
+		if x.IsZero() {
+			break
+		}
+		stripOnePlusI(x)
+	}
+	// restore the common factor (1+i)^shift = (2i)^(shift/2) * (1+i)^(shift%2) up to the unit
+	y.R.Lsh(y.R, shift/2)
+	y.I.Lsh(y.I, shift/2)
+	if shift%2 == 1 {
+		y.Prod(y, NewGaussianInt(big1, big1))
+	}
+	g.Normalize(y)
+	return new(GaussianInt).Set(g)
+}
+
+// isOnePlusIMultiple returns true if the Gaussian integer is divisible by 1+i, i.e. R + I is even
+func isOnePlusIMultiple(x *GaussianInt) bool {
+	return x.R.Bit(0) == x.I.Bit(0)
+}
+
+// divOnePlusI divides the Gaussian integer by 1+i in place, i.e. (R + Ii) / (1+i) = ((R + I) + (I - R)i) / 2
+// the Gaussian integer must be divisible by 1+i
+func divOnePlusI(x *GaussianInt) {
+	opt := iPool.Get().(*big.Int).Add(x.R, x.I)
+	defer iPool.Put(opt)
+	x.I.Sub(x.I, x.R)
+	x.I.Rsh(x.I, 1)
+	x.R.Rsh(opt, 1)
+}
+
+// stripOnePlusI divides the non-zero Gaussian integer by 1+i in place until it is not divisible by 1+i
+func stripOnePlusI(x *GaussianInt) {
+	// 2 = -i(1+i)^2, so the factors of 2 common to both parts are removed by shifting first
+	shift := x.R.TrailingZeroBits()
+	if s := x.I.TrailingZeroBits(); x.R.Sign() == 0 || x.I.Sign() != 0 && s < shift {
+		shift = s
+	}
+	x.R.Rsh(x.R, shift)
+	x.I.Rsh(x.I, shift)
+	for isOnePlusIMultiple(x) {
+		divOnePlusI(x)
+	}
+}
+
+// subUnitMultiple replaces x by x - u * y for the unit u such that the difference is divisible by (1+i)^3
+// both x and y must not be divisible by 1+i, and then exactly one unit satisfies the condition
+// since the four units represent the residue classes of such Gaussian integers modulo (1+i)^3
+func subUnitMultiple(x, y *GaussianInt) {
+	// the parts modulo 4 are enough to test divisibility by (1+i)^3, i.e. both parts of the difference are even
+	// and their sum is divisible by 4
+	xR, xI := mod4(x.R), mod4(x.I)
+	yR, yI := mod4(y.R), mod4(y.I)
+	// u * y for u = 1, -1, i, -i is y.R + y.I i, -y.R - y.I i, -y.I + y.R i, and y.I - y.R i
+	for u, uy := range [4][2]uint{{yR, yI}, {4 - yR, 4 - yI}, {4 - yI, yR}, {yI, 4 - yR}} {
+		dR, dI := (xR+4-uy[0])%4, (xI+4-uy[1])%4
+		if dR%2 != 0 || dI%2 != 0 || (dR+dI)%4 != 0 {
+			continue
+		}
+		switch u {
+		case 0:
+			x.R.Sub(x.R, y.R)
+			x.I.Sub(x.I, y.I)
+		case 1:
+			x.R.Add(x.R, y.R)
+			x.I.Add(x.I, y.I)
+		case 2:
+			x.R.Add(x.R, y.I)
+			x.I.Sub(x.I, y.R)
+		case 3:
+			x.R.Sub(x.R, y.I)
+			x.I.Add(x.I, y.R)
+		}
+		return
+	}
+}
+
+// mod4 returns the big integer modulo 4 in the range [0, 4) from the two lowest bits of its two's complement
+func mod4(x *big.Int) uint {
+	return x.Bit(1)<<1 | x.Bit(0)
+}
@@ -0,0 +1,75 @@
+// MIT License
+//
+// Copyright (c) 2022 Tommy TIAN
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package complex
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func TestGaussianInt_BinaryGCD(t *testing.T) {
+	tests := []struct {
+		name string
+		a    *GaussianInt
+		b    *GaussianInt
+		want *GaussianInt
+	}{
+		{"test_(6+3i)_(5i)", NewGaussianInt(big.NewInt(6), big.NewInt(3)), NewGaussianInt(big.NewInt(0), big.NewInt(5)), NewGaussianInt(big.NewInt(2), big.NewInt(1))},
+		{"test_(4)_(2+2i)", NewGaussianInt(big.NewInt(4), big.NewInt(0)), NewGaussianInt(big.NewInt(2), big.NewInt(2)), NewGaussianInt(big.NewInt(2), big.NewInt(2))},
+		{"test_(8i)_(-6)", NewGaussianInt(big.NewInt(0), big.NewInt(8)), NewGaussianInt(big.NewInt(-6), big.NewInt(0)), NewGaussianInt(big.NewInt(2), big.NewInt(0))},
+		{"test_(5+6i)_(1+2i)", NewGaussianInt(big.NewInt(5), big.NewInt(6)), NewGaussianInt(big.NewInt(1), big.NewInt(2)), NewGaussianInt(big.NewInt(1), big.NewInt(0))},
+		{"test_(0)_(-3+4i)", NewGaussianInt(big.NewInt(0), big.NewInt(0)), NewGaussianInt(big.NewInt(-3), big.NewInt(4)), NewGaussianInt(big.NewInt(4), big.NewInt(3))},
+		{"test_(0)_(0)", NewGaussianInt(big.NewInt(0), big.NewInt(0)), NewGaussianInt(big.NewInt(0), big.NewInt(0)), NewGaussianInt(big.NewInt(0), big.NewInt(0))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := new(GaussianInt)
+			if got := g.BinaryGCD(tt.a, tt.b); !got.Equals(tt.want) || !g.Equals(tt.want) {
+				t.Errorf("BinaryGCD() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	r := rand.New(rand.NewSource(1))
+	bound := new(big.Int).Lsh(big1, 100)
+	onePlusI := NewGaussianInt(big1, big1)
+	for n := 0; n < 1000; n++ {
+		a := NewGaussianInt(new(big.Int).Rand(r, bound), new(big.Int).Rand(r, bound))
+		b := NewGaussianInt(new(big.Int).Rand(r, bound), new(big.Int).Rand(r, bound))
+		a.R.Neg(a.R)
+		common := NewGaussianInt(big.NewInt(r.Int63n(1000)), big.NewInt(r.Int63n(1000)))
+		a.Prod(a, common)
+		b.Prod(b, common)
+		for k := r.Intn(20); k > 0; k-- {
+			a.Prod(a, onePlusI)
+		}
+		for k := r.Intn(20); k > 0; k-- {
+			b.Prod(b, onePlusI)
+		}
+		want := new(GaussianInt).GCD(a, b)
+		if got := new(GaussianInt).BinaryGCD(a, b); !got.Equals(want) {
+			t.Fatalf("BinaryGCD(%v, %v) = %v, want %v", a, b, got, want)
+		}
+	}
+}
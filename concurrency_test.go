@@ -0,0 +1,86 @@
+// MIT License
+//
+// Copyright (c) 2022 Tommy TIAN
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package complex
+
+import (
+	"math/big"
+	"math/rand"
+	"sync"
+	"testing"
+)
+
+// TestConcurrentArithmetic runs arithmetic on shared operands from many goroutines,
+// run it with -race to detect data races on the operands, the pools, and the constants
+func TestConcurrentArithmetic(t *testing.T) {
+	const goroutines, rounds = 16, 50
+	r := rand.New(rand.NewSource(1))
+	bound := new(big.Int).Lsh(big1, 200)
+	gis := make([]*GaussianInt, 8)
+	for idx := range gis {
+		gis[idx] = NewGaussianInt(new(big.Int).Rand(r, bound), new(big.Int).Rand(r, bound))
+	}
+	his := make([]*HurwitzInt, 8)
+	for idx := range his {
+		his[idx] = randHurwitzInt(r, 1<<40)
+	}
+	// sequential results to compare with
+	wantProd := new(GaussianInt).Prod(gis[0], gis[1])
+	wantQuo := new(GaussianInt).Div(gis[2], gis[3])
+	wantGCD := new(GaussianInt).GCD(gis[4], gis[5])
+	wantHProd := new(HurwitzInt).Prod(his[0], his[1])
+	wantHQuo := new(HurwitzInt).Div(his[2], his[3])
+	wantGCRD := new(HurwitzInt).GCRD(his[4], his[5])
+
+	var wg sync.WaitGroup
+	errs := make(chan string, goroutines)
+	for n := 0; n < goroutines; n++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for k := 0; k < rounds; k++ {
+				if !new(GaussianInt).Prod(gis[0], gis[1]).Equals(wantProd) ||
+					!new(GaussianInt).Div(gis[2], gis[3]).Equals(wantQuo) ||
+					!new(GaussianInt).GCD(gis[4], gis[5]).Equals(wantGCD) ||
+					!new(HurwitzInt).Prod(his[0], his[1]).Equals(wantHProd) ||
+					!new(HurwitzInt).Div(his[2], his[3]).Equals(wantHQuo) ||
+					!new(HurwitzInt).GCRD(his[4], his[5]).Equals(wantGCRD) {
+					errs <- "concurrent result differs from the sequential result"
+					return
+				}
+				his[7].ValInt()
+				new(GaussianInt).Reset()
+				HurwitzZero().Add(&HurwitzInt{}, his[6])
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Fatal(err)
+	}
+
+	if big0.Sign() != 0 || big1.Cmp(big.NewInt(1)) != 0 || bigNeg1.Cmp(big.NewInt(-1)) != 0 ||
+		big2.Cmp(big.NewInt(2)) != 0 || big2f.Cmp(big.NewFloat(2)) != 0 {
+		t.Errorf("shared constants were modified")
+	}
+}
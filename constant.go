@@ -25,19 +25,20 @@ package complex
 import "math/big"
 
 const (
-	// the delta added or subtracted to round big floats to the nearest integers
-	roundingDelta = 0.49
+	// the bit length of the larger part of both operands from which Gaussian integer
+	// multiplication trades one big integer multiplication for three additions,
+	// tuned with BenchmarkGaussianInt_Prod
+	prodThreeMulThreshold = 1024
 )
 
+// The constants are shared by all goroutines, so they must only be used as read-only operands
 var (
 	// big integer
+	big0    = big.NewInt(0)
 	big1    = big.NewInt(1)
 	bigNeg1 = big.NewInt(-1)
 	big2    = big.NewInt(2)
 
 	// big float
 	big2f = big.NewFloat(2)
-
-	// delta for rounding big float to big int
-	rDelta = big.NewFloat(roundingDelta)
 )
@@ -0,0 +1,31 @@
+// MIT License
+//
+// Copyright (c) 2022 Tommy TIAN
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package complex implements big complex number types with math/big: Gaussian integers (GaussianInt),
+// Hurwitz quaternions (HurwitzInt), and Lipschitz quaternions (LipschitzInt)
+//
+// Concurrency: like big.Int, a value is safe for concurrent reads, but a method must not run concurrently with
+// another method that writes the same value. Methods only write the receiver that stores the result and never
+// modify their operands, so calls on distinct receivers may share operands across goroutines. The package-level
+// sync.Pools of temporaries and the big number constants are safe for concurrent use: the pools are internally
+// synchronized, every pooled value is exclusively owned between Get and Put, and the constants are only ever read.
+package complex
@@ -0,0 +1,286 @@
+// MIT License
+//
+// Copyright (c) 2022 Tommy TIAN
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package complex
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"io"
+	"math"
+	"math/big"
+)
+
+// The binary format of a big integer is a sign byte (0 for non-negative, 1 for negative),
+// followed by the byte length of the absolute value as an unsigned varint,
+// followed by the absolute value in big-endian byte order
+// The binary format of a Gaussian integer is the real part followed by the imaginary part
+// A stream of Gaussian integers is prefixed by the count of Gaussian integers as an unsigned varint
+// The binary format of a Hurwitz integer is its four doubled components 2r, 2i, 2j, and 2k in order,
+// which are all even for an integer quaternion and all odd for a half-integer one
+// The JSON format of a Hurwitz integer is an object holding the four doubled components as decimal strings
+// and a flag telling whether they are odd, e.g. {"doubled":["1","-3","1","5"],"half":true} for 0.5-1.5i+0.5j+2.5k
+
+const (
+	signNonNegative byte = 0
+	signNegative    byte = 1
+	// the maximum number of Gaussian integers preallocated when decoding a stream
+	maxPrealloc = 1 << 16
+)
+
+// ErrInvalidEncoding is returned when decoding malformed binary data
+var ErrInvalidEncoding = errors.New("invalid binary encoding")
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface
+func (g *GaussianInt) MarshalBinary() ([]byte, error) {
+	b := appendBinaryInt(nil, g.R)
+	return appendBinaryInt(b, g.I), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface
+func (g *GaussianInt) UnmarshalBinary(data []byte) error {
+	r := bytes.NewReader(data)
+	if err := g.decode(r); err != nil {
+		return err
+	}
+	if r.Len() != 0 {
+		return ErrInvalidEncoding
+	}
+	return nil
+}
+
+// EncodeGaussianInts writes the Gaussian integers to the writer in binary format with a count prefix
+func EncodeGaussianInts(w io.Writer, xs []*GaussianInt) error {
+	bw := bufio.NewWriter(w)
+	b := appendUvarint(nil, uint64(len(xs)))
+	if _, err := bw.Write(b); err != nil {
+		return err
+	}
+	for _, x := range xs {
+		b = appendBinaryInt(b[:0], x.R)
+		b = appendBinaryInt(b, x.I)
+		if _, err := bw.Write(b); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+// DecodeGaussianInts reads the Gaussian integers written by EncodeGaussianInts from the reader
+// Truncated input results in io.ErrUnexpectedEOF
+// No bytes past the end of the stream are consumed, so the reader can hold further data after it;
+// a reader without ReadByte is read with unbuffered exact-length reads, so wrap it in a bufio.Reader
+// for speed if reading past the stream does not matter
+func DecodeGaussianInts(r io.Reader) ([]*GaussianInt, error) {
+	br, ok := r.(byteReader)
+	if !ok {
+		br = exactByteReader{r}
+	}
+	count, err := binary.ReadUvarint(br)
+	if err != nil {
+		return nil, unexpectedEOF(err)
+	}
+	// the count prefix is not trusted for preallocation
+	capacity := count
+	if capacity > maxPrealloc {
+		capacity = maxPrealloc
+	}
+	xs := make([]*GaussianInt, 0, capacity)
+	for n := uint64(0); n < count; n++ {
+		g := new(GaussianInt)
+		if err = g.decode(br); err != nil {
+			return nil, err
+		}
+		xs = append(xs, g)
+	}
+	return xs, nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface
+func (h *HurwitzInt) MarshalBinary() ([]byte, error) {
+	r, i, j, k := h.doubled()
+	b := appendBinaryInt(nil, r)
+	b = appendBinaryInt(b, i)
+	b = appendBinaryInt(b, j)
+	return appendBinaryInt(b, k), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface
+// Doubled components of mixed parity are rejected with ErrInvalidEncoding
+func (h *HurwitzInt) UnmarshalBinary(data []byte) error {
+	r := bytes.NewReader(data)
+	var dbl [4]*big.Int
+	for idx := range dbl {
+		x, err := readBinaryInt(r)
+		if err != nil {
+			return err
+		}
+		dbl[idx] = x
+	}
+	if r.Len() != 0 || !sameParity(dbl) {
+		return ErrInvalidEncoding
+	}
+	h.dblR, h.dblI, h.dblJ, h.dblK = dbl[0], dbl[1], dbl[2], dbl[3]
+	return nil
+}
+
+// hurwitzJSON is the JSON format of a Hurwitz integer
+type hurwitzJSON struct {
+	Doubled [4]string `json:"doubled"`
+	Half    bool      `json:"half"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (h *HurwitzInt) MarshalJSON() ([]byte, error) {
+	r, i, j, k := h.doubled()
+	return json.Marshal(hurwitzJSON{
+		Doubled: [4]string{r.String(), i.String(), j.String(), k.String()},
+		Half:    r.Bit(0) == 1,
+	})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+// ErrMixedParity is returned if the doubled components do not all have the parity given by the half flag
+// JSON null is a no-op and leaves the Hurwitz integer unchanged, following the encoding/json convention
+func (h *HurwitzInt) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return nil
+	}
+	var v hurwitzJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	var dbl [4]*big.Int
+	for idx, s := range v.Doubled {
+		x, ok := new(big.Int).SetString(s, 10)
+		if !ok {
+			return ErrInvalidSyntax
+		}
+		dbl[idx] = x
+	}
+	if !sameParity(dbl) || (dbl[0].Bit(0) == 1) != v.Half {
+		return ErrMixedParity
+	}
+	h.dblR, h.dblI, h.dblJ, h.dblK = dbl[0], dbl[1], dbl[2], dbl[3]
+	return nil
+}
+
+// sameParity returns true if the doubled components are all even or all odd
+func sameParity(dbl [4]*big.Int) bool {
+	p := dbl[0].Bit(0)
+	return dbl[1].Bit(0) == p && dbl[2].Bit(0) == p && dbl[3].Bit(0) == p
+}
+
+// byteReader is the reader needed for decoding
+type byteReader interface {
+	io.Reader
+	io.ByteReader
+}
+
+// exactByteReader adds ReadByte to a reader by reading exactly one byte at a time,
+// unlike bufio.Reader, which may read ahead past the data needed
+type exactByteReader struct {
+	io.Reader
+}
+
+// ReadByte implements the io.ByteReader interface
+func (r exactByteReader) ReadByte() (byte, error) {
+	var b [1]byte
+	if _, err := io.ReadFull(r.Reader, b[:]); err != nil {
+		return 0, err
+	}
+	return b[0], nil
+}
+
+func (g *GaussianInt) decode(r byteReader) error {
+	re, err := readBinaryInt(r)
+	if err != nil {
+		return err
+	}
+	im, err := readBinaryInt(r)
+	if err != nil {
+		return err
+	}
+	g.R, g.I = re, im
+	return nil
+}
+
+// appendUvarint appends the unsigned varint encoding of x to the buffer
+func appendUvarint(b []byte, x uint64) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(buf[:], x)
+	return append(b, buf[:n]...)
+}
+
+// appendBinaryInt appends the binary format of the big integer to the buffer
+func appendBinaryInt(b []byte, x *big.Int) []byte {
+	if x.Sign() < 0 {
+		b = append(b, signNegative)
+	} else {
+		b = append(b, signNonNegative)
+	}
+	n := (x.BitLen() + 7) / 8
+	b = appendUvarint(b, uint64(n))
+	start := len(b)
+	b = append(b, make([]byte, n)...)
+	x.FillBytes(b[start:])
+	return b
+}
+
+// readBinaryInt reads a big integer in binary format from the reader
+func readBinaryInt(r byteReader) (*big.Int, error) {
+	sign, err := r.ReadByte()
+	if err != nil {
+		return nil, unexpectedEOF(err)
+	}
+	if sign != signNonNegative && sign != signNegative {
+		return nil, ErrInvalidEncoding
+	}
+	n, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, unexpectedEOF(err)
+	}
+	if n > math.MaxInt64 {
+		return nil, ErrInvalidEncoding
+	}
+	// the buffer grows with the data actually read instead of trusting the length prefix
+	var buf bytes.Buffer
+	if _, err = io.CopyN(&buf, r, int64(n)); err != nil {
+		return nil, unexpectedEOF(err)
+	}
+	x := new(big.Int).SetBytes(buf.Bytes())
+	if sign == signNegative {
+		x.Neg(x)
+	}
+	return x, nil
+}
+
+// unexpectedEOF converts io.EOF to io.ErrUnexpectedEOF, since any EOF while decoding means truncated input
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
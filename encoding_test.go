@@ -0,0 +1,226 @@
+// MIT License
+//
+// Copyright (c) 2022 Tommy TIAN
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package complex
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func TestGaussianInt_MarshalBinary(t *testing.T) {
+	tests := []*GaussianInt{
+		NewGaussianInt(big.NewInt(0), big.NewInt(0)),
+		NewGaussianInt(big.NewInt(-1), big.NewInt(256)),
+		NewGaussianInt(new(big.Int).Lsh(big.NewInt(-3), 200), big.NewInt(7)),
+	}
+	for _, g := range tests {
+		t.Run(g.String(), func(t *testing.T) {
+			data, err := g.MarshalBinary()
+			if err != nil {
+				t.Fatalf("MarshalBinary() error = %v", err)
+			}
+			got := new(GaussianInt)
+			if err = got.UnmarshalBinary(data); err != nil {
+				t.Fatalf("UnmarshalBinary() error = %v", err)
+			}
+			if !got.Equals(g) {
+				t.Errorf("UnmarshalBinary() = %v, want %v", got, g)
+			}
+		})
+	}
+}
+
+func TestDecodeGaussianInts(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	xs := make([]*GaussianInt, 5000)
+	for idx := range xs {
+		re := new(big.Int).Rand(r, new(big.Int).Lsh(big.NewInt(1), uint(r.Intn(300))))
+		im := new(big.Int).Rand(r, new(big.Int).Lsh(big.NewInt(1), uint(r.Intn(300))))
+		if r.Intn(2) == 0 {
+			re.Neg(re)
+		}
+		if r.Intn(2) == 0 {
+			im.Neg(im)
+		}
+		xs[idx] = NewGaussianInt(re, im)
+	}
+	var buf bytes.Buffer
+	if err := EncodeGaussianInts(&buf, xs); err != nil {
+		t.Fatalf("EncodeGaussianInts() error = %v", err)
+	}
+	data := buf.Bytes()
+	got, err := DecodeGaussianInts(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("DecodeGaussianInts() error = %v", err)
+	}
+	if len(got) != len(xs) {
+		t.Fatalf("DecodeGaussianInts() returned %d Gaussian integers, want %d", len(got), len(xs))
+	}
+	for idx := range xs {
+		if !got[idx].Equals(xs[idx]) {
+			t.Fatalf("DecodeGaussianInts()[%d] = %v, want %v", idx, got[idx], xs[idx])
+		}
+	}
+	for _, size := range []int{0, 1, len(data) / 2, len(data) - 1} {
+		if _, err = DecodeGaussianInts(bytes.NewReader(data[:size])); err != io.ErrUnexpectedEOF {
+			t.Errorf("DecodeGaussianInts() of %d truncated bytes error = %v, want %v", size, err, io.ErrUnexpectedEOF)
+		}
+		if _, err = DecodeGaussianInts(struct{ io.Reader }{bytes.NewReader(data[:size])}); err != io.ErrUnexpectedEOF {
+			t.Errorf("DecodeGaussianInts() of %d truncated bytes from a plain reader error = %v, want %v", size, err, io.ErrUnexpectedEOF)
+		}
+	}
+}
+
+func TestDecodeGaussianInts_Trailer(t *testing.T) {
+	xs := []*GaussianInt{NewGaussianInt(big.NewInt(3), big.NewInt(-4))}
+	tests := []struct {
+		name string
+		wrap func(*bytes.Buffer) io.Reader
+	}{
+		{"test_byte_reader", func(buf *bytes.Buffer) io.Reader { return buf }},
+		{"test_plain_reader", func(buf *bytes.Buffer) io.Reader { return struct{ io.Reader }{buf} }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := EncodeGaussianInts(&buf, xs); err != nil {
+				t.Fatalf("EncodeGaussianInts() error = %v", err)
+			}
+			buf.WriteString("TRAILER")
+			got, err := DecodeGaussianInts(tt.wrap(&buf))
+			if err != nil || len(got) != 1 || !got[0].Equals(xs[0]) {
+				t.Fatalf("DecodeGaussianInts() = %v, %v, want %v", got, err, xs)
+			}
+			if rest := buf.String(); rest != "TRAILER" {
+				t.Errorf("DecodeGaussianInts() left %q unread, want %q", rest, "TRAILER")
+			}
+		})
+	}
+}
+
+func TestHurwitzInt_MarshalBinary(t *testing.T) {
+	huge := new(big.Int).Lsh(big.NewInt(-3), 1000)
+	tests := []*HurwitzInt{
+		HurwitzZero(),
+		NewHurwitzInt(big.NewInt(1), big.NewInt(-3), big.NewInt(1), big.NewInt(5), true),
+		NewHurwitzInt(huge, big.NewInt(7), big.NewInt(0), new(big.Int).Neg(huge), false),
+		NewHurwitzInt(new(big.Int).Add(huge, big1), big.NewInt(7), big.NewInt(-1), new(big.Int).Sub(huge, big1), true),
+	}
+	for _, h := range tests {
+		t.Run(h.String(), func(t *testing.T) {
+			data, err := h.MarshalBinary()
+			if err != nil {
+				t.Fatalf("MarshalBinary() error = %v", err)
+			}
+			got := new(HurwitzInt)
+			if err = got.UnmarshalBinary(data); err != nil {
+				t.Fatalf("UnmarshalBinary() error = %v", err)
+			}
+			if !got.Equals(h) {
+				t.Errorf("UnmarshalBinary() = %v, want %v", got, h)
+			}
+		})
+	}
+}
+
+func TestHurwitzInt_UnmarshalBinaryInvalid(t *testing.T) {
+	var b []byte
+	for _, x := range []int64{1, 2, 1, 1} {
+		b = appendBinaryInt(b, big.NewInt(x))
+	}
+	if err := new(HurwitzInt).UnmarshalBinary(b); err != ErrInvalidEncoding {
+		t.Errorf("UnmarshalBinary() error = %v, want %v", err, ErrInvalidEncoding)
+	}
+	data, _ := HurwitzOne().MarshalBinary()
+	if err := new(HurwitzInt).UnmarshalBinary(data[:len(data)-1]); err != io.ErrUnexpectedEOF {
+		t.Errorf("UnmarshalBinary() error = %v, want %v", err, io.ErrUnexpectedEOF)
+	}
+}
+
+func TestHurwitzInt_MarshalJSON(t *testing.T) {
+	huge := new(big.Int).Lsh(big.NewInt(5), 2000)
+	tests := []struct {
+		name string
+		h    *HurwitzInt
+		want string
+	}{
+		{"test_zero", HurwitzZero(), `{"doubled":["0","0","0","0"],"half":false}`},
+		{"test_half", NewHurwitzInt(big.NewInt(1), big.NewInt(-3), big.NewInt(1), big.NewInt(5), true), `{"doubled":["1","-3","1","5"],"half":true}`},
+		{"test_large_integer", NewHurwitzInt(huge, big.NewInt(-2), big.NewInt(0), big.NewInt(3), false), ""},
+		{"test_large_half", NewHurwitzInt(new(big.Int).Add(huge, big1), big.NewInt(-1), big.NewInt(1), new(big.Int).Neg(huge).Sub(new(big.Int).Neg(huge), big1), true), ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.h)
+			if err != nil {
+				t.Fatalf("MarshalJSON() error = %v", err)
+			}
+			if tt.want != "" && string(data) != tt.want {
+				t.Errorf("MarshalJSON() = %s, want %s", data, tt.want)
+			}
+			got := new(HurwitzInt)
+			if err = json.Unmarshal(data, got); err != nil {
+				t.Fatalf("UnmarshalJSON() error = %v", err)
+			}
+			if !got.Equals(tt.h) {
+				t.Errorf("UnmarshalJSON() = %v, want %v", got, tt.h)
+			}
+		})
+	}
+}
+
+func TestHurwitzInt_UnmarshalJSONNull(t *testing.T) {
+	h := NewHurwitzInt(big.NewInt(1), big.NewInt(3), big.NewInt(-5), big.NewInt(7), true)
+	want := h.Copy()
+	if err := h.UnmarshalJSON([]byte("null")); err != nil || !h.Equals(want) {
+		t.Errorf("UnmarshalJSON(null) = %v, %v, want %v, nil", h, err, want)
+	}
+	v := struct{ H *HurwitzInt }{H: h}
+	if err := json.Unmarshal([]byte(`{"H":null}`), &v); err != nil || v.H != nil {
+		t.Errorf("json.Unmarshal() = %v, %v, want nil field", v.H, err)
+	}
+}
+
+func TestHurwitzInt_UnmarshalJSONInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr error
+	}{
+		{"test_mixed_parity", `{"doubled":["1","2","1","1"],"half":true}`, ErrMixedParity},
+		{"test_wrong_flag", `{"doubled":["1","3","1","1"],"half":false}`, ErrMixedParity},
+		{"test_not_decimal", `{"doubled":["0x2","0","0","0"],"half":false}`, ErrInvalidSyntax},
+		{"test_missing_components", `{"half":false}`, ErrInvalidSyntax},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := new(HurwitzInt).UnmarshalJSON([]byte(tt.data)); err != tt.wantErr {
+				t.Errorf("UnmarshalJSON() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,246 @@
+// MIT License
+//
+// Copyright (c) 2022 Tommy TIAN
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package complex
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Format implements the fmt.Formatter interface
+// %v, %s, and %d print the same form as String, %x and %X print both parts in hexadecimal, e.g. 0x1f+0x2ai and 0X1F+0X2Ai,
+// and %#v prints the Go syntax representation complex.NewGaussianInt(big.NewInt(r), big.NewInt(i)),
+// which is valid Go code only if both parts fit in int64
+// The width, precision and flags apply to the whole output like %s, e.g. %-10v pads on the right,
+// and the other verbs such as %q format the result of String as fmt does for a fmt.Stringer
+func (g *GaussianInt) Format(s fmt.State, verb rune) {
+	var out string
+	switch verb {
+	case 'v':
+		if s.Flag('#') {
+			out = fmt.Sprintf("complex.NewGaussianInt(big.NewInt(%s), big.NewInt(%s))", g.R.String(), g.I.String())
+			break
+		}
+		out = g.String()
+	case 's', 'd':
+		out = g.String()
+	case 'x', 'X':
+		b := appendHexInt(nil, g.R, verb)
+		if g.I.Sign() >= 0 {
+			b = append(b, '+')
+		}
+		b = appendHexInt(b, g.I, verb)
+		out = string(append(b, 'i'))
+	default:
+		fmt.Fprintf(s, formatString(s, verb), g.String())
+		return
+	}
+	fmt.Fprintf(s, formatString(s, 's'), out)
+}
+
+// formatString returns the directive that reconstructs the flags, width and precision of the state
+// followed by the verb, like fmt.FormatString added in Go 1.20
+func formatString(s fmt.State, verb rune) string {
+	b := []byte{'%'}
+	for _, c := range " +-#0" {
+		if s.Flag(int(c)) {
+			b = append(b, byte(c))
+		}
+	}
+	if w, ok := s.Width(); ok {
+		b = strconv.AppendInt(b, int64(w), 10)
+	}
+	if p, ok := s.Precision(); ok {
+		b = append(b, '.')
+		b = strconv.AppendInt(b, int64(p), 10)
+	}
+	return string(append(b, string(verb)...))
+}
+
+// StringOptions controls the string representation given by StringWithOptions
+// The zero value gives the same form as String
+type StringOptions struct {
+	// LeadingPlus adds a + sign before a positive leading part, e.g. +3+4i and +4i, for aligned tabular output
+	LeadingPlus bool
+	// ExplicitZeros always writes both parts, even when they are zero, e.g. 3+0i and 0-i
+	ExplicitZeros bool
+	// ImagSymbol is the symbol of the imaginary unit, i if empty
+	ImagSymbol string
+}
+
+// StringWithOptions returns the string representation of the Gaussian integer controlled by the options
+// The coefficients 1 and -1 of the imaginary part are omitted like String, e.g. 3-i
+func (g *GaussianInt) StringWithOptions(opts StringOptions) string {
+	return string(g.appendWithOptions(nil, opts))
+}
+
+// StringWithSymbol returns the string representation of the Gaussian integer like String,
+// but with the given symbol for the imaginary unit, e.g. 3+4j with the engineering symbol j
+func (g *GaussianInt) StringWithSymbol(sym string) string {
+	return g.StringWithOptions(StringOptions{ImagSymbol: sym})
+}
+
+// appendWithOptions appends the string representation of the Gaussian integer controlled by the options
+// to the buffer and returns the extended buffer
+func (g *GaussianInt) appendWithOptions(b []byte, opts StringOptions) []byte {
+	rSign := g.R.Sign()
+	iSign := g.I.Sign()
+	writeR := rSign != 0 || iSign == 0 || opts.ExplicitZeros
+	if writeR {
+		if rSign > 0 && opts.LeadingPlus {
+			b = append(b, '+')
+		}
+		b = g.R.Append(b, 10)
+	}
+	if iSign == 0 && !opts.ExplicitZeros {
+		return b
+	}
+	if iSign >= 0 && (writeR || opts.LeadingPlus) {
+		b = append(b, '+')
+	}
+	if g.I.Cmp(bigNeg1) == 0 {
+		b = append(b, '-')
+	} else if g.I.Cmp(big1) != 0 {
+		b = g.I.Append(b, 10)
+	}
+	if opts.ImagSymbol == "" {
+		return append(b, 'i')
+	}
+	return append(b, opts.ImagSymbol...)
+}
+
+// appendHexInt appends the big integer in hexadecimal with a 0x (or 0X for %X) prefix to the buffer
+func appendHexInt(b []byte, x *big.Int, verb rune) []byte {
+	if x.Sign() < 0 {
+		b = append(b, '-')
+	}
+	abs := iPool.Get().(*big.Int).Abs(x)
+	defer iPool.Put(abs)
+	if verb == 'X' {
+		b = append(b, "0X"...)
+		return append(b, strings.ToUpper(abs.Text(16))...)
+	}
+	b = append(b, "0x"...)
+	return abs.Append(b, 16)
+}
+
+// LaTeX returns the LaTeX representation of the Gaussian integer, e.g. 3 + 4i
+func (g *GaussianInt) LaTeX() string {
+	var b []byte
+	leading := true
+	for _, part := range []struct {
+		val  *big.Int
+		unit string
+	}{
+		{g.R, ""},
+		{g.I, "i"},
+	} {
+		if part.val.Sign() == 0 {
+			continue
+		}
+		abs := iPool.Get().(*big.Int).Abs(part.val)
+		b = appendLaTeXTerm(b, leading, part.val.Sign(), abs, false, part.unit)
+		iPool.Put(abs)
+		leading = false
+	}
+	if leading {
+		return "0"
+	}
+	return string(b)
+}
+
+// LaTeX returns the LaTeX representation of the Hurwitz integer,
+// with half-integer scalars written as fractions, e.g. \tfrac{1}{2} + \tfrac{3}{2}i - \tfrac{1}{2}j + \tfrac{1}{2}k
+func (h *HurwitzInt) LaTeX() string {
+	var b []byte
+	leading := true
+	dblR, dblI, dblJ, dblK := h.doubled()
+	for _, part := range []struct {
+		dbl  *big.Int
+		unit string
+	}{
+		{dblR, ""},
+		{dblI, "i"},
+		{dblJ, "j"},
+		{dblK, "k"},
+	} {
+		if part.dbl.Sign() == 0 {
+			continue
+		}
+		abs := iPool.Get().(*big.Int).Abs(part.dbl)
+		half := abs.Bit(0) == 1
+		if !half {
+			abs.Rsh(abs, 1)
+		}
+		b = appendLaTeXTerm(b, leading, part.dbl.Sign(), abs, half, part.unit)
+		iPool.Put(abs)
+		leading = false
+	}
+	if leading {
+		return "0"
+	}
+	return string(b)
+}
+
+// StringParen returns the string representation of the Hurwitz integer like String, but writes a
+// half-integer one in the conventional notation with a common denominator, e.g. (1-i+3j+k)/2
+// instead of 0.5-0.5i+1.5j+0.5k
+func (h *HurwitzInt) StringParen() string {
+	if h.IsLipschitz() {
+		return h.String()
+	}
+	dblR, dblI, dblJ, dblK := h.doubled()
+	// each doubled scalar is odd, so none of them is omitted, and doubling it again
+	// makes hiAppendScalar write it as an integer
+	opt := iPool.Get().(*big.Int)
+	defer iPool.Put(opt)
+	b := []byte{'('}
+	b = hiAppendScalar(b, opt.Lsh(dblR, 1), "", true)
+	b = hiAppendScalar(b, opt.Lsh(dblI, 1), "i", false)
+	b = hiAppendScalar(b, opt.Lsh(dblJ, 1), "j", false)
+	b = hiAppendScalar(b, opt.Lsh(dblK, 1), "k", false)
+	return string(append(b, ")/2"...))
+}
+
+// appendLaTeXTerm appends a signed term of a LaTeX sum to the buffer
+// If half is true, the coefficient of the term is abs / 2
+func appendLaTeXTerm(b []byte, leading bool, sign int, abs *big.Int, half bool, unit string) []byte {
+	switch {
+	case leading && sign < 0:
+		b = append(b, '-')
+	case !leading && sign < 0:
+		b = append(b, " - "...)
+	case !leading:
+		b = append(b, " + "...)
+	}
+	if half {
+		b = append(b, `\tfrac{`...)
+		b = abs.Append(b, 10)
+		b = append(b, "}{2}"...)
+	} else if abs.Cmp(big1) != 0 || unit == "" {
+		b = abs.Append(b, 10)
+	}
+	return append(b, unit...)
+}
@@ -0,0 +1,265 @@
+// MIT License
+//
+// Copyright (c) 2022 Tommy TIAN
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package complex
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+)
+
+func TestGaussianInt_LaTeX(t *testing.T) {
+	tests := []struct {
+		name string
+		g    *GaussianInt
+		want string
+	}{
+		{
+			name: "test_3+4i",
+			g:    NewGaussianInt(big.NewInt(3), big.NewInt(4)),
+			want: "3 + 4i",
+		},
+		{
+			name: "test_-1-i",
+			g:    NewGaussianInt(big.NewInt(-1), big.NewInt(-1)),
+			want: "-1 - i",
+		},
+		{
+			name: "test_-i",
+			g:    NewGaussianInt(big.NewInt(0), big.NewInt(-1)),
+			want: "-i",
+		},
+		{
+			name: "test_0",
+			g:    NewGaussianInt(big.NewInt(0), big.NewInt(0)),
+			want: "0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.g.LaTeX(); got != tt.want {
+				t.Errorf("LaTeX() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHurwitzInt_LaTeX(t *testing.T) {
+	tests := []struct {
+		name string
+		h    *HurwitzInt
+		want string
+	}{
+		{
+			name: "test_0.5+0.5i+0.5j+0.5k",
+			h:    NewHurwitzInt(big.NewInt(1), big.NewInt(1), big.NewInt(1), big.NewInt(1), true),
+			want: `\tfrac{1}{2} + \tfrac{1}{2}i + \tfrac{1}{2}j + \tfrac{1}{2}k`,
+		},
+		{
+			name: "test_-1.5i+0.5j-2.5k-0.5",
+			h:    NewHurwitzInt(big.NewInt(-1), big.NewInt(-3), big.NewInt(1), big.NewInt(-5), true),
+			want: `-\tfrac{1}{2} - \tfrac{3}{2}i + \tfrac{1}{2}j - \tfrac{5}{2}k`,
+		},
+		{
+			name: "test_2-j",
+			h:    NewHurwitzInt(big.NewInt(2), big.NewInt(0), big.NewInt(-1), big.NewInt(0), false),
+			want: "2 - j",
+		},
+		{
+			name: "test_0",
+			h:    NewHurwitzInt(big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), false),
+			want: "0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.h.LaTeX(); got != tt.want {
+				t.Errorf("LaTeX() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGaussianInt_Format(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		g      *GaussianInt
+		want   string
+	}{
+		{
+			name:   "test_%v",
+			format: "%v",
+			g:      NewGaussianInt(big.NewInt(31), big.NewInt(-42)),
+			want:   "31-42i",
+		},
+		{
+			name:   "test_%s",
+			format: "%s",
+			g:      NewGaussianInt(big.NewInt(0), big.NewInt(1)),
+			want:   "i",
+		},
+		{
+			name:   "test_%x",
+			format: "%x",
+			g:      NewGaussianInt(big.NewInt(31), big.NewInt(42)),
+			want:   "0x1f+0x2ai",
+		},
+		{
+			name:   "test_%X",
+			format: "%X",
+			g:      NewGaussianInt(big.NewInt(-31), big.NewInt(-42)),
+			want:   "-0X1F-0X2Ai",
+		},
+		{
+			name:   "test_%#v",
+			format: "%#v",
+			g:      NewGaussianInt(big.NewInt(3), big.NewInt(-4)),
+			want:   "complex.NewGaussianInt(big.NewInt(3), big.NewInt(-4))",
+		},
+		{
+			name:   "test_%10v",
+			format: "%10v",
+			g:      NewGaussianInt(big.NewInt(1), big.NewInt(2)),
+			want:   "      1+2i",
+		},
+		{
+			name:   "test_%-10s|",
+			format: "%-10s|",
+			g:      NewGaussianInt(big.NewInt(1), big.NewInt(2)),
+			want:   "1+2i      |",
+		},
+		{
+			name:   "test_%12x",
+			format: "%12x",
+			g:      NewGaussianInt(big.NewInt(31), big.NewInt(-42)),
+			want:   "  0x1f-0x2ai",
+		},
+		{
+			name:   "test_%q",
+			format: "%q",
+			g:      NewGaussianInt(big.NewInt(1), big.NewInt(2)),
+			want:   `"1+2i"`,
+		},
+		{
+			name:   "test_%8q",
+			format: "%8q",
+			g:      NewGaussianInt(big.NewInt(0), big.NewInt(-1)),
+			want:   `    "-i"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := fmt.Sprintf(tt.format, tt.g); got != tt.want {
+				t.Errorf("Sprintf(%q) = %v, want %v", tt.format, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGaussianInt_StringWithOptions(t *testing.T) {
+	newG := func(r, i int64) *GaussianInt {
+		return NewGaussianInt(big.NewInt(r), big.NewInt(i))
+	}
+	leadingPlus := StringOptions{LeadingPlus: true}
+	explicitZeros := StringOptions{ExplicitZeros: true}
+	all := StringOptions{LeadingPlus: true, ExplicitZeros: true, ImagSymbol: "j"}
+	tests := []struct {
+		name string
+		g    *GaussianInt
+		opts StringOptions
+		want string
+	}{
+		{"test_leading_plus_3+4i", newG(3, 4), leadingPlus, "+3+4i"},
+		{"test_leading_plus_-3+4i", newG(-3, 4), leadingPlus, "-3+4i"},
+		{"test_leading_plus_4i", newG(0, 4), leadingPlus, "+4i"},
+		{"test_leading_plus_i", newG(0, 1), leadingPlus, "+i"},
+		{"test_leading_plus_-i", newG(0, -1), leadingPlus, "-i"},
+		{"test_leading_plus_3", newG(3, 0), leadingPlus, "+3"},
+		{"test_leading_plus_0", newG(0, 0), leadingPlus, "0"},
+		{"test_explicit_zeros_3", newG(3, 0), explicitZeros, "3+0i"},
+		{"test_explicit_zeros_-i", newG(0, -1), explicitZeros, "0-i"},
+		{"test_explicit_zeros_0", newG(0, 0), explicitZeros, "0+0i"},
+		{"test_explicit_zeros_3-4i", newG(3, -4), explicitZeros, "3-4i"},
+		{"test_symbol_3+4i", newG(3, 4), StringOptions{ImagSymbol: "j"}, "3+4j"},
+		{"test_all_5", newG(5, 0), all, "+5+0j"},
+		{"test_all_-i", newG(0, -1), all, "0-j"},
+		{"test_all_2+i", newG(2, 1), all, "+2+j"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.g.StringWithOptions(tt.opts); got != tt.want {
+				t.Errorf("StringWithOptions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	for _, g := range []*GaussianInt{newG(0, 0), newG(3, 4), newG(-1, -1), newG(0, 1), newG(7, 0)} {
+		if got := g.StringWithOptions(StringOptions{}); got != g.String() {
+			t.Errorf("StringWithOptions() with zero options = %v, want %v", got, g.String())
+		}
+	}
+}
+
+func TestGaussianInt_StringWithSymbol(t *testing.T) {
+	tests := []struct {
+		g    *GaussianInt
+		sym  string
+		want string
+	}{
+		{NewGaussianInt(big.NewInt(3), big.NewInt(4)), "j", "3+4j"},
+		{NewGaussianInt(big.NewInt(3), big.NewInt(-1)), "j", "3-j"},
+		{NewGaussianInt(big.NewInt(0), big.NewInt(1)), "j", "j"},
+		{NewGaussianInt(big.NewInt(5), big.NewInt(0)), "j", "5"},
+		{NewGaussianInt(big.NewInt(3), big.NewInt(4)), "", "3+4i"},
+		{NewGaussianInt(big.NewInt(-2), big.NewInt(7)), "·I", "-2+7·I"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.g.StringWithSymbol(tt.sym); got != tt.want {
+				t.Errorf("StringWithSymbol() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHurwitzInt_StringParen(t *testing.T) {
+	tests := []struct {
+		h    *HurwitzInt
+		want string
+	}{
+		{NewHurwitzInt(big.NewInt(1), big.NewInt(1), big.NewInt(1), big.NewInt(1), true), "(1+i+j+k)/2"},
+		{NewHurwitzInt(big.NewInt(-1), big.NewInt(1), big.NewInt(1), big.NewInt(1), true), "(-1+i+j+k)/2"},
+		{NewHurwitzInt(big.NewInt(1), big.NewInt(-1), big.NewInt(1), big.NewInt(-1), true), "(1-i+j-k)/2"},
+		{NewHurwitzInt(big.NewInt(-1), big.NewInt(-1), big.NewInt(-1), big.NewInt(-1), true), "(-1-i-j-k)/2"},
+		{NewHurwitzInt(big.NewInt(3), big.NewInt(-1), big.NewInt(5), big.NewInt(-7), true), "(3-i+5j-7k)/2"},
+		{NewHurwitzInt(big.NewInt(2), big.NewInt(0), big.NewInt(-1), big.NewInt(3), false), "2-j+3k"},
+		{HurwitzZero(), "0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.h.StringParen(); got != tt.want {
+				t.Errorf("StringParen() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -35,28 +35,24 @@ type GaussianInt struct {
 
 // String returns the string representation of the Gaussian integer
 func (g *GaussianInt) String() string {
-	rSign := g.R.Sign()
-	iSign := g.I.Sign()
-	res := ""
-	if rSign != 0 {
-		res += g.R.String()
-	}
-	if iSign == 0 {
-		if res == "" {
-			return "0"
-		}
-		return res
-	}
-	if iSign == 1 && rSign != 0 {
-		res += "+"
-	}
-	if g.I.Cmp(bigNeg1) == 0 {
-		res += "-"
-	} else if g.I.Cmp(big1) != 0 {
-		res += g.I.String()
+	return string(g.Append(nil))
+}
+
+// Append appends the string representation of the Gaussian integer to the buffer
+// and returns the extended buffer
+func (g *GaussianInt) Append(b []byte) []byte {
+	return g.appendWithOptions(b, StringOptions{})
+}
+
+// StringCanonical returns the canonical string representation of the Gaussian integer, R+Ii or R-|I|i,
+// which always spells out both coefficients, e.g. 0+1i and 3+0i, and is accepted by ParseGaussianIntCanonical
+func (g *GaussianInt) StringCanonical() string {
+	b := g.R.Append(nil, 10)
+	if g.I.Sign() >= 0 {
+		b = append(b, '+')
 	}
-	res += "i"
-	return res
+	b = g.I.Append(b, 10)
+	return string(append(b, 'i'))
 }
 
 // NewGaussianInt declares a new Gaussian integer with the real part and imaginary part
@@ -67,16 +63,52 @@ func NewGaussianInt(r *big.Int, i *big.Int) *GaussianInt {
 	}
 }
 
+// One returns a new Gaussian integer equal to one
+func One() *GaussianInt {
+	return &GaussianInt{
+		R: big.NewInt(1),
+		I: big.NewInt(0),
+	}
+}
+
+// ImagUnit returns a new Gaussian integer equal to the imaginary unit i
+func ImagUnit() *GaussianInt {
+	return &GaussianInt{
+		R: big.NewInt(0),
+		I: big.NewInt(1),
+	}
+}
+
+// Real returns a copy of the real part of the Gaussian integer
+// The real part of a zero value Gaussian integer is zero
+func (g *GaussianInt) Real() *big.Int {
+	if g.R == nil {
+		return new(big.Int)
+	}
+	return new(big.Int).Set(g.R)
+}
+
+// Imag returns a copy of the imaginary part of the Gaussian integer
+// The imaginary part of a zero value Gaussian integer is zero
+func (g *GaussianInt) Imag() *big.Int {
+	if g.I == nil {
+		return new(big.Int)
+	}
+	return new(big.Int).Set(g.I)
+}
+
 // Set sets the Gaussian integer to the given Gaussian integer
+// nil parts of the given Gaussian integer are read as zero
 func (g *GaussianInt) Set(a *GaussianInt) *GaussianInt {
+	r, i := a.parts()
 	if g.R == nil {
 		g.R = new(big.Int)
 	}
-	g.R.Set(a.R)
+	g.R.Set(r)
 	if g.I == nil {
 		g.I = new(big.Int)
 	}
-	g.I.Set(a.I)
+	g.I.Set(i)
 	return g
 }
 
@@ -93,6 +125,19 @@ func (g *GaussianInt) Update(r, i *big.Int) *GaussianInt {
 	return g
 }
 
+// Reset sets the Gaussian integer to zero in place, reusing the storage of the existing big integers
+func (g *GaussianInt) Reset() *GaussianInt {
+	if g.R == nil {
+		g.R = new(big.Int)
+	}
+	g.R.SetInt64(0)
+	if g.I == nil {
+		g.I = new(big.Int)
+	}
+	g.I.SetInt64(0)
+	return g
+}
+
 // Add adds two Gaussian integers
 func (g *GaussianInt) Add(a, b *GaussianInt) *GaussianInt {
 	if g.R == nil {
@@ -120,15 +165,78 @@ func (g *GaussianInt) Sub(a, b *GaussianInt) *GaussianInt {
 }
 
 // Prod returns the products of two Gaussian integers
+// The product is computed in pooled temporaries and then stored in the big integers of the Gaussian integer
+// that calls the method, so a receiver from AcquireGaussianInt is reused without allocating
 func (g *GaussianInt) Prod(a, b *GaussianInt) *GaussianInt {
-	r := new(big.Int).Mul(a.R, b.R)
+	if a.BitLen() >= prodThreeMulThreshold && b.BitLen() >= prodThreeMulThreshold {
+		return g.prodThreeMul(a, b)
+	}
+	r := iPool.Get().(*big.Int).Mul(a.R, b.R)
+	defer iPool.Put(r)
 	opt := iPool.Get().(*big.Int)
 	defer iPool.Put(opt)
 	r.Sub(r, opt.Mul(a.I, b.I))
-	i := new(big.Int).Mul(a.R, b.I)
+	i := iPool.Get().(*big.Int).Mul(a.R, b.I)
+	defer iPool.Put(i)
 	i.Add(i, opt.Mul(a.I, b.R))
-	g.R, g.I = r, i
-	return g
+	return g.Update(r, i)
+}
+
+// Mul multiplies two Gaussian integers like Prod, matching the naming of math/big
+func (g *GaussianInt) Mul(a, b *GaussianInt) *GaussianInt {
+	return g.Prod(a, b)
+}
+
+// AddMul sets the Gaussian integer to g + a * b, the fused multiply-add used in Horner evaluation and matrix products
+// The product is computed in a single pooled temporary, so a, b and g may alias each other
+// the result is stored in the Gaussian integer that calls the method and returned
+func (g *GaussianInt) AddMul(a, b *GaussianInt) *GaussianInt {
+	prod := giPool.Get().(*GaussianInt).Prod(a, b)
+	defer giPool.Put(prod)
+	r, i := g.parts()
+	return g.Update(r, i).Add(g, prod)
+}
+
+// SubMul sets the Gaussian integer to g - a * b like AddMul
+// the result is stored in the Gaussian integer that calls the method and returned
+func (g *GaussianInt) SubMul(a, b *GaussianInt) *GaussianInt {
+	prod := giPool.Get().(*GaussianInt).Prod(a, b)
+	defer giPool.Put(prod)
+	r, i := g.parts()
+	return g.Update(r, i).Sub(g, prod)
+}
+
+// Powers returns the powers g^0, g^1, ..., g^n of the Gaussian integer as a new slice of length n+1
+// Each power is the product of the previous one and g, so the table costs n multiplications
+// If n is negative, the result is an empty slice
+func (g *GaussianInt) Powers(n int) []*GaussianInt {
+	if n < 0 {
+		return []*GaussianInt{}
+	}
+	res := make([]*GaussianInt, n+1)
+	res[0] = One()
+	for k := 1; k <= n; k++ {
+		res[k] = new(GaussianInt).Prod(res[k-1], g)
+	}
+	return res
+}
+
+// prodThreeMul computes the product with three multiplications instead of four:
+// k1 = c(a+b), k2 = a(d-c), k3 = b(c+d), real part = k1-k3, imaginary part = k1+k2
+func (g *GaussianInt) prodThreeMul(a, b *GaussianInt) *GaussianInt {
+	k1 := iPool.Get().(*big.Int)
+	defer iPool.Put(k1)
+	k2 := iPool.Get().(*big.Int)
+	defer iPool.Put(k2)
+	opt := iPool.Get().(*big.Int)
+	defer iPool.Put(opt)
+	k1.Mul(b.R, opt.Add(a.R, a.I))
+	k2.Mul(a.R, opt.Sub(b.I, b.R))
+	// k2 becomes the imaginary part and opt the real part
+	k2.Add(k1, k2)
+	opt.Mul(a.I, opt.Add(b.R, b.I))
+	opt.Sub(k1, opt)
+	return g.Update(opt, k2)
 }
 
 // Conj obtains the conjugate of the original Gaussian integer
@@ -138,6 +246,64 @@ func (g *GaussianInt) Conj(origin *GaussianInt) *GaussianInt {
 	return g
 }
 
+// ConjInPlace conjugates the Gaussian integer in place
+func (g *GaussianInt) ConjInPlace() *GaussianInt {
+	if g.R == nil {
+		g.R = new(big.Int)
+	}
+	if g.I == nil {
+		g.I = new(big.Int)
+	}
+	g.I.Neg(g.I)
+	return g
+}
+
+// Normalize obtains the canonical associate of the original Gaussian integer,
+// i.e. the associate in the first quadrant with positive real part and non-negative imaginary part
+// The canonical associate of zero is zero
+func (g *GaussianInt) Normalize(origin *GaussianInt) *GaussianInt {
+	r := new(big.Int).Set(origin.R)
+	i := new(big.Int).Set(origin.I)
+	switch {
+	case r.Sign() <= 0 && i.Sign() > 0:
+		// multiply by -i
+		r, i = i, r.Neg(r)
+	case r.Sign() < 0 && i.Sign() <= 0:
+		// multiply by -1
+		r.Neg(r)
+		i.Neg(i)
+	case r.Sign() >= 0 && i.Sign() < 0:
+		// multiply by i
+		r, i = i.Neg(i), r
+	}
+	g.R, g.I = r, i
+	return g
+}
+
+// Associates returns the four associates of the Gaussian integer, i.e. g, ig, -g, and -ig
+func (g *GaussianInt) Associates() []*GaussianInt {
+	negR := new(big.Int).Neg(g.R)
+	negI := new(big.Int).Neg(g.I)
+	return []*GaussianInt{
+		NewGaussianInt(g.R, g.I),
+		NewGaussianInt(negI, g.R),
+		NewGaussianInt(negR, negI),
+		NewGaussianInt(g.I, negR),
+	}
+}
+
+// IsAssociate returns true if the two Gaussian integers differ by a unit, i.e. a is one of g, ig, -g, and -ig
+func (g *GaussianInt) IsAssociate(a *GaussianInt) bool {
+	negR := iPool.Get().(*big.Int).Neg(g.R)
+	defer iPool.Put(negR)
+	negI := iPool.Get().(*big.Int).Neg(g.I)
+	defer iPool.Put(negI)
+	return a.R.Cmp(g.R) == 0 && a.I.Cmp(g.I) == 0 ||
+		a.R.Cmp(negI) == 0 && a.I.Cmp(g.R) == 0 ||
+		a.R.Cmp(negR) == 0 && a.I.Cmp(negI) == 0 ||
+		a.R.Cmp(g.I) == 0 && a.I.Cmp(negR) == 0
+}
+
 // Norm obtains the norm of the Gaussian integer
 func (g *GaussianInt) Norm() *big.Int {
 	norm := new(big.Int).Mul(g.R, g.R)
@@ -147,50 +313,148 @@ func (g *GaussianInt) Norm() *big.Int {
 	return norm
 }
 
+// ScaledInverse returns conj(g) and N(g) as new values, so that g * conj(g) = N(g) and conj(g) / N(g) is the
+// inverse of g in Q(i), e.g. for clearing the Gaussian denominator of a / g as a * conj(g) / N(g)
+// For zero both results are zero
+func (g *GaussianInt) ScaledInverse() (*GaussianInt, *big.Int) {
+	return new(GaussianInt).Conj(g), g.Norm()
+}
+
+// NormOfProduct returns the norm of a * b as N(a) * N(b), since the norm is multiplicative,
+// without computing the product itself
+func NormOfProduct(a, b *GaussianInt) *big.Int {
+	norm := a.Norm()
+	opt := iPool.Get().(*big.Int).Mul(b.R, b.R)
+	defer iPool.Put(opt)
+	opt2 := iPool.Get().(*big.Int).Mul(b.I, b.I)
+	defer iPool.Put(opt2)
+	return norm.Mul(norm, opt.Add(opt, opt2))
+}
+
+// Trace obtains the trace of the Gaussian integer, i.e. twice the real part
+func (g *GaussianInt) Trace() *big.Int {
+	return new(big.Int).Lsh(g.R, 1)
+}
+
+// BitLen returns the bit length of the absolute value of the larger-magnitude part of the Gaussian integer
+// The bit length of zero is 0
+func (g *GaussianInt) BitLen() int {
+	rLen, iLen := g.R.BitLen(), g.I.BitLen()
+	if rLen > iLen {
+		return rLen
+	}
+	return iLen
+}
+
 // Copy copies the Gaussian integer
+// nil parts, e.g. of the zero value GaussianInt{}, are copied as zero
 func (g *GaussianInt) Copy() *GaussianInt {
-	return NewGaussianInt(
-		new(big.Int).Set(g.R),
-		new(big.Int).Set(g.I),
-	)
+	return NewGaussianInt(g.parts())
+}
+
+// parts returns the real and imaginary parts, reading nil parts as zero
+func (g *GaussianInt) parts() (r, i *big.Int) {
+	r, i = g.R, g.I
+	if r == nil {
+		r = big0
+	}
+	if i == nil {
+		i = big0
+	}
+	return r, i
+}
+
+// Clone is an alias of Copy
+func (g *GaussianInt) Clone() *GaussianInt {
+	return g.Copy()
 }
 
 // Div performs Euclidean division of two Gaussian integers, i.e. a/b
 // the remainder is stored in the Gaussian integer that calls the method
 // the quotient is returned as a new Gaussian integer
+// The real and imaginary parts of the exact quotient a * conj(b) / N(b) are rounded independently to the nearest
+// integers (ties toward zero) by the exact integer rounding shared with RoundFloat, so each is off by at most 1/2 and the remainder
+// always satisfies N(r) <= N(b) / 2, the best possible bound for Z[i]
 func (g *GaussianInt) Div(a, b *GaussianInt) *GaussianInt {
 	bConj := giPool.Get().(*GaussianInt).Conj(b)
 	defer giPool.Put(bConj)
 	numerator := giPool.Get().(*GaussianInt).Prod(a, bConj)
 	defer giPool.Put(numerator)
-	denominator := giPool.Get().(*GaussianInt).Prod(b, bConj)
-	defer giPool.Put(denominator)
-	deFloat := fPool.Get().(*big.Float).SetInt(denominator.R)
-	defer fPool.Put(deFloat)
-
-	realScalar := fPool.Get().(*big.Float).SetInt(numerator.R)
-	defer fPool.Put(realScalar)
-	realScalar.Quo(realScalar, deFloat)
-	imagScalar := fPool.Get().(*big.Float).SetInt(numerator.I)
-	defer fPool.Put(imagScalar)
-	imagScalar.Quo(imagScalar, deFloat)
-
-	rsInt := iPool.Get().(*big.Int)
-	defer iPool.Put(rsInt)
-	rsInt = roundFloat(realScalar)
-	isInt := iPool.Get().(*big.Int)
-	defer iPool.Put(isInt)
-	isInt = roundFloat(imagScalar)
-	quotient := NewGaussianInt(rsInt, isInt)
+	denominator := b.Norm()
+	quotient := NewGaussianInt(
+		roundQuo(numerator.R, denominator),
+		roundQuo(numerator.I, denominator),
+	)
 	opt := giPool.Get().(*GaussianInt)
 	defer giPool.Put(opt)
 	g.Sub(a, opt.Prod(quotient, b))
 	return quotient
 }
 
+// DivCheck performs Euclidean division of two Gaussian integers like Div, i.e. a/b
+// the remainder is stored in the Gaussian integer that calls the method
+// the quotient is returned as a new Gaussian integer, together with whether the Euclidean property holds,
+// i.e. the norm of the remainder is strictly smaller than the norm of b
+func (g *GaussianInt) DivCheck(a, b *GaussianInt) (*GaussianInt, bool) {
+	bNorm := b.Norm()
+	quotient := g.Div(a, b)
+	return quotient, g.Norm().Cmp(bNorm) < 0
+}
+
+// VerifyEuclideanProperty divides a by b with Div and returns true if the norm of the remainder is strictly
+// smaller than the norm of b, the property the Euclidean algorithm relies on, e.g. for property tests
+// over random inputs of any size; Div rounds exactly, so it returns false only for b = 0
+func VerifyEuclideanProperty(a, b *GaussianInt) bool {
+	if b.IsZero() {
+		return false
+	}
+	remainder := giPool.Get().(*GaussianInt)
+	defer giPool.Put(remainder)
+	_, ok := remainder.DivCheck(a, b)
+	return ok
+}
+
+// DivExactRat returns the exact real and imaginary parts of the complex quotient a/b as rational numbers,
+// i.e. a * conj(b) / N(b), without rounding to a Gaussian integer
+// b must not be zero, and the Gaussian integer that calls the method is not modified
+func (g *GaussianInt) DivExactRat(a, b *GaussianInt) (reRat, imRat *big.Rat) {
+	bConj := giPool.Get().(*GaussianInt).Conj(b)
+	defer giPool.Put(bConj)
+	numerator := giPool.Get().(*GaussianInt).Prod(a, bConj)
+	defer giPool.Put(numerator)
+	norm := b.Norm()
+	reRat = new(big.Rat).SetFrac(numerator.R, norm)
+	imRat = new(big.Rat).SetFrac(numerator.I, norm)
+	return reRat, imRat
+}
+
 // Equals checks if two Gaussian integers are equal
+// nil parts, e.g. those of a zero value Gaussian integer, are treated as zero
 func (g *GaussianInt) Equals(a *GaussianInt) bool {
-	return g.R.Cmp(a.R) == 0 && g.I.Cmp(a.I) == 0
+	return intEquals(g.R, a.R) && intEquals(g.I, a.I)
+}
+
+// EqualGaussian checks if two Gaussian integers are equal without panicking on nil
+// Two nil Gaussian integers are equal, a nil and a non-nil Gaussian integer are not,
+// and nil parts are treated as zero
+func EqualGaussian(a, b *GaussianInt) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Equals(b)
+}
+
+// intEquals checks if two big integers are equal, treating nil as zero
+func intEquals(a, b *big.Int) bool {
+	switch {
+	case a == nil && b == nil:
+		return true
+	case a == nil:
+		return b.Sign() == 0
+	case b == nil:
+		return a.Sign() == 0
+	}
+	return a.Cmp(b) == 0
 }
 
 // IsZero returns true if the Gaussian integer is equal to zero
@@ -200,7 +464,27 @@ func (g *GaussianInt) IsZero() bool {
 
 // IsOne returns true if the Gaussian integer is equal to one
 func (g *GaussianInt) IsOne() bool {
-	return g.R.Sign() == 1 && g.I.Sign() == 0
+	return g.R.Cmp(big1) == 0 && g.I.Sign() == 0
+}
+
+// IsReal returns true if the imaginary part of the Gaussian integer is zero, i.e. it is a rational integer
+// nil parts are treated as zero, so zero and the zero value are real
+func (g *GaussianInt) IsReal() bool {
+	_, i := g.parts()
+	return i.Sign() == 0
+}
+
+// IsImaginary returns true if the Gaussian integer is purely imaginary,
+// i.e. the real part is zero and the imaginary part is not
+// nil parts are treated as zero
+func (g *GaussianInt) IsImaginary() bool {
+	r, i := g.parts()
+	return r.Sign() == 0 && i.Sign() != 0
+}
+
+// IsUnit returns true if the Gaussian integer is a unit, i.e. one of 1, -1, i, and -i
+func (g *GaussianInt) IsUnit() bool {
+	return g.Norm().Cmp(big1) == 0
 }
 
 // CmpNorm compares the norm of two Gaussian integers
@@ -208,9 +492,47 @@ func (g *GaussianInt) CmpNorm(a *GaussianInt) int {
 	return g.Norm().Cmp(a.Norm())
 }
 
+// Cmp compares two Gaussian integers in a total order and returns -1, 0, or +1
+// Gaussian integers are ordered by their norms first, then by their real parts,
+// and then by their imaginary parts
+func (g *GaussianInt) Cmp(a *GaussianInt) int {
+	if c := g.CmpNorm(a); c != 0 {
+		return c
+	}
+	if c := g.R.Cmp(a.R); c != 0 {
+		return c
+	}
+	return g.I.Cmp(a.I)
+}
+
 // GCD calculates the greatest common divisor of two Gaussian integers using Euclidean algorithm
+// The GCD is unique only up to multiplication by a unit, so the canonical associate given by Normalize
+// is chosen to make the result reproducible
 // the result is stored in the Gaussian integer that calls the method and returned
 func (g *GaussianInt) GCD(a, b *GaussianInt) *GaussianInt {
+	gcd, _ := g.GCDWithSteps(a, b)
+	return gcd
+}
+
+// IsCoprime returns true if the greatest common divisor of the two Gaussian integers is a unit
+// It relies on GCD, which stops as soon as a remainder is a unit
+// Zero is coprime only to the units
+func (g *GaussianInt) IsCoprime(a *GaussianInt) bool {
+	if g.IsZero() || a.IsZero() {
+		return g.IsUnit() || a.IsUnit()
+	}
+	gcd := giPool.Get().(*GaussianInt)
+	defer giPool.Put(gcd)
+	gcd.GCD(g, a)
+	return gcd.IsOne()
+}
+
+// GCDWithSteps calculates the greatest common divisor like GCD and also returns the number of
+// Euclidean division steps taken
+// The algorithm stops as soon as a remainder is a unit, so coprime inputs skip the final division:
+// the count is one less than the number of remainders given by GCDSteps if the GCD is a unit, and equal otherwise
+// the result is stored in the Gaussian integer that calls the method and returned
+func (g *GaussianInt) GCDWithSteps(a, b *GaussianInt) (*GaussianInt, int) {
 	ac := giPool.Get().(*GaussianInt).Set(a)
 	defer giPool.Put(ac)
 	bc := giPool.Get().(*GaussianInt).Set(b)
@@ -221,13 +543,111 @@ func (g *GaussianInt) GCD(a, b *GaussianInt) *GaussianInt {
 	}
 	remainder := giPool.Get().(*GaussianInt)
 	defer giPool.Put(remainder)
-	for {
+	for steps := 0; ; steps++ {
+		if bc.IsUnit() {
+			// a unit divides every Gaussian integer, so the two Gaussian integers are coprime
+			g.Update(big1, big0)
+			return One(), steps
+		}
 		remainder.Div(ac, bc)
 		if remainder.IsZero() {
-			g.Set(bc)
-			return new(GaussianInt).Set(bc)
+			g.Normalize(bc)
+			return new(GaussianInt).Set(g), steps + 1
 		}
 		ac.Set(bc)
 		bc.Set(remainder)
 	}
 }
+
+// GCDSteps runs the Euclidean algorithm like GCD and also returns the sequence of remainders it produces,
+// ending with the zero remainder, so the number of division steps is the length of the sequence
+// Unlike GCDWithSteps, it does not stop at a unit remainder, so for coprime inputs the sequence ends with
+// a unit followed by zero, one division more than the count of GCDWithSteps
+// The Euclidean algorithm starts by dividing the operand with the larger norm by the other one,
+// and the norms of the remainders are strictly decreasing since each quotient is rounded to the nearest
+// Gaussian integer
+// The greatest common divisor is the canonical associate of the last non-zero remainder given by Normalize,
+// it is stored in the Gaussian integer that calls the method and a copy is returned
+func (g *GaussianInt) GCDSteps(a, b *GaussianInt) ([]*GaussianInt, *GaussianInt) {
+	ac := new(GaussianInt).Set(a)
+	bc := new(GaussianInt).Set(b)
+	if ac.CmpNorm(bc) < 0 {
+		ac, bc = bc, ac
+	}
+	var remainders []*GaussianInt
+	for !bc.IsZero() {
+		remainder := new(GaussianInt)
+		remainder.Div(ac, bc)
+		remainders = append(remainders, remainder)
+		ac, bc = bc, remainder
+	}
+	g.Normalize(ac)
+	return remainders, new(GaussianInt).Set(g)
+}
+
+// ContinuedFraction returns the Gaussian continued fraction expansion [q0; q1, ..., qn] of a/b,
+// i.e. a/b = q0 + 1/(q1 + 1/(... + 1/qn)), which is the sequence of quotients of the Euclidean algorithm
+// each partial quotient is computed by Div, rounding the real and imaginary parts of the exact quotient
+// to the nearest integer with ties rounded towards zero
+// the last non-zero remainder, a greatest common divisor of a and b, is stored in the Gaussian integer
+// that calls the method; if b is zero, the expansion is empty
+func (g *GaussianInt) ContinuedFraction(a, b *GaussianInt) []*GaussianInt {
+	ac := new(GaussianInt).Set(a)
+	bc := new(GaussianInt).Set(b)
+	var quotients []*GaussianInt
+	for !bc.IsZero() {
+		remainder := new(GaussianInt)
+		quotients = append(quotients, remainder.Div(ac, bc))
+		ac, bc = bc, remainder
+	}
+	g.Set(ac)
+	return quotients
+}
+
+// Sum returns the sum of the given Gaussian integers
+// the sum of an empty list is zero
+func Sum(xs ...*GaussianInt) *GaussianInt {
+	sum := NewGaussianInt(big0, big0)
+	for _, x := range xs {
+		sum.Add(sum, x)
+	}
+	return sum
+}
+
+// Product returns the product of the given Gaussian integers
+// the product of an empty list is one
+func Product(xs ...*GaussianInt) *GaussianInt {
+	prod := One()
+	for _, x := range xs {
+		prod.Prod(prod, x)
+	}
+	return prod
+}
+
+// Content returns the content of the Gaussian integers, i.e. the non-negative greatest common divisor
+// of all their real and imaginary parts, which can be factored out of every element
+// the content of an empty or all-zero list is zero
+func Content(xs []*GaussianInt) *big.Int {
+	content := new(big.Int)
+	for _, x := range xs {
+		content.GCD(nil, nil, content, x.R)
+		content.GCD(nil, nil, content, x.I)
+	}
+	return content
+}
+
+// CloneGaussianInts returns a new slice of deep copies of the Gaussian integers, made with Copy for each element,
+// so that the copies can be mutated without affecting the originals, e.g. when handing them to other goroutines
+// nil elements stay nil, and a nil slice gives a nil slice
+func CloneGaussianInts(xs []*GaussianInt) []*GaussianInt {
+	if xs == nil {
+		return nil
+	}
+	res := make([]*GaussianInt, len(xs))
+	for i, x := range xs {
+		if x != nil {
+			res[i] = x.Copy()
+		}
+	}
+	return res
+}
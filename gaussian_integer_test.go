@@ -24,6 +24,7 @@ package complex
 
 import (
 	"math/big"
+	"math/rand"
 	"reflect"
 	"testing"
 )
@@ -129,7 +130,10 @@ func TestGaussianInt_Div(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			g := &GaussianInt{}
-			quotient := g.Div(tt.args.a, tt.args.b)
+			quotient, ok := g.DivCheck(tt.args.a, tt.args.b)
+			if !ok {
+				t.Errorf("DivCheck() remainder %v, norm not smaller than divisor %v", g, tt.args.b)
+			}
 			if g.R.Cmp(tt.wantReminder.R) != 0 || g.I.Cmp(tt.wantReminder.I) != 0 {
 				t.Errorf("g = %v, want reminder %v", g, tt.wantReminder)
 			}
@@ -329,6 +333,39 @@ func TestGaussianInt_Equals(t *testing.T) {
 			},
 			want: true,
 		},
+		{
+			name: "test_nil==0",
+			fields: fields{
+				R: nil,
+				I: nil,
+			},
+			args: args{
+				a: NewGaussianInt(big.NewInt(0), big.NewInt(0)),
+			},
+			want: true,
+		},
+		{
+			name: "test_nil!=1",
+			fields: fields{
+				R: nil,
+				I: nil,
+			},
+			args: args{
+				a: NewGaussianInt(big.NewInt(1), big.NewInt(0)),
+			},
+			want: false,
+		},
+		{
+			name: "test_i==nil+i",
+			fields: fields{
+				R: big.NewInt(0),
+				I: big.NewInt(1),
+			},
+			args: args{
+				a: &GaussianInt{I: big.NewInt(1)},
+			},
+			want: true,
+		},
 		{
 			name: "test_-1+i!=1+i",
 			fields: fields{
@@ -353,3 +390,995 @@ func TestGaussianInt_Equals(t *testing.T) {
 		})
 	}
 }
+
+func TestSum(t *testing.T) {
+	tests := []struct {
+		name string
+		xs   []*GaussianInt
+		want *GaussianInt
+	}{
+		{
+			name: "test_empty",
+			xs:   nil,
+			want: NewGaussianInt(big.NewInt(0), big.NewInt(0)),
+		},
+		{
+			name: "test_(1+i)",
+			xs: []*GaussianInt{
+				NewGaussianInt(big.NewInt(1), big.NewInt(1)),
+			},
+			want: NewGaussianInt(big.NewInt(1), big.NewInt(1)),
+		},
+		{
+			name: "test_(2+i)+(2-i)+(-3+5i)",
+			xs: []*GaussianInt{
+				NewGaussianInt(big.NewInt(2), big.NewInt(1)),
+				NewGaussianInt(big.NewInt(2), big.NewInt(-1)),
+				NewGaussianInt(big.NewInt(-3), big.NewInt(5)),
+			},
+			want: NewGaussianInt(big.NewInt(1), big.NewInt(5)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Sum(tt.xs...); !got.Equals(tt.want) {
+				t.Errorf("Sum() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProduct(t *testing.T) {
+	tests := []struct {
+		name string
+		xs   []*GaussianInt
+		want *GaussianInt
+	}{
+		{
+			name: "test_empty",
+			xs:   nil,
+			want: NewGaussianInt(big.NewInt(1), big.NewInt(0)),
+		},
+		{
+			name: "test_(1+i)(1-i)",
+			xs: []*GaussianInt{
+				NewGaussianInt(big.NewInt(1), big.NewInt(1)),
+				NewGaussianInt(big.NewInt(1), big.NewInt(-1)),
+			},
+			want: NewGaussianInt(big.NewInt(2), big.NewInt(0)),
+		},
+		{
+			name: "test_(2+i)(2-i)(3)",
+			xs: []*GaussianInt{
+				NewGaussianInt(big.NewInt(2), big.NewInt(1)),
+				NewGaussianInt(big.NewInt(2), big.NewInt(-1)),
+				NewGaussianInt(big.NewInt(3), big.NewInt(0)),
+			},
+			want: NewGaussianInt(big.NewInt(15), big.NewInt(0)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Product(tt.xs...); !got.Equals(tt.want) {
+				t.Errorf("Product() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestContent(t *testing.T) {
+	tests := []struct {
+		name string
+		xs   []*GaussianInt
+		want *big.Int
+	}{
+		{"test_empty", nil, big.NewInt(0)},
+		{"test_all_zero", []*GaussianInt{NewGaussianInt(big.NewInt(0), big.NewInt(0))}, big.NewInt(0)},
+		{
+			"test_(2+4i)_(6+2i)",
+			[]*GaussianInt{
+				NewGaussianInt(big.NewInt(2), big.NewInt(4)),
+				NewGaussianInt(big.NewInt(6), big.NewInt(2)),
+			},
+			big.NewInt(2),
+		},
+		{
+			"test_(-9i)_(0)_(-6+15i)",
+			[]*GaussianInt{
+				NewGaussianInt(big.NewInt(0), big.NewInt(-9)),
+				NewGaussianInt(big.NewInt(0), big.NewInt(0)),
+				NewGaussianInt(big.NewInt(-6), big.NewInt(15)),
+			},
+			big.NewInt(3),
+		},
+		{
+			"test_coprime",
+			[]*GaussianInt{
+				NewGaussianInt(big.NewInt(4), big.NewInt(6)),
+				NewGaussianInt(big.NewInt(9), big.NewInt(0)),
+			},
+			big.NewInt(1),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Content(tt.xs); got.Cmp(tt.want) != 0 {
+				t.Errorf("Content() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGaussianInt_GCD(t *testing.T) {
+	type args struct {
+		a *GaussianInt
+		b *GaussianInt
+	}
+	tests := []struct {
+		name string
+		args args
+		want *GaussianInt
+	}{
+		{
+			name: "test_(6+3i)_(5i)",
+			args: args{
+				a: NewGaussianInt(big.NewInt(6), big.NewInt(3)),
+				b: NewGaussianInt(big.NewInt(0), big.NewInt(5)),
+			},
+			want: NewGaussianInt(big.NewInt(2), big.NewInt(1)),
+		},
+		{
+			name: "test_(5i)_(6+3i)",
+			args: args{
+				a: NewGaussianInt(big.NewInt(0), big.NewInt(5)),
+				b: NewGaussianInt(big.NewInt(6), big.NewInt(3)),
+			},
+			want: NewGaussianInt(big.NewInt(2), big.NewInt(1)),
+		},
+		{
+			name: "test_(-3+6i)_(-5)",
+			args: args{
+				a: NewGaussianInt(big.NewInt(-3), big.NewInt(6)),
+				b: NewGaussianInt(big.NewInt(-5), big.NewInt(0)),
+			},
+			want: NewGaussianInt(big.NewInt(2), big.NewInt(1)),
+		},
+		{
+			name: "test_(7+3i)_(-i)",
+			args: args{
+				a: NewGaussianInt(big.NewInt(7), big.NewInt(3)),
+				b: NewGaussianInt(big.NewInt(0), big.NewInt(-1)),
+			},
+			want: NewGaussianInt(big.NewInt(1), big.NewInt(0)),
+		},
+		{
+			name: "test_(5+6i)_(1+2i)",
+			args: args{
+				a: NewGaussianInt(big.NewInt(5), big.NewInt(6)),
+				b: NewGaussianInt(big.NewInt(1), big.NewInt(2)),
+			},
+			want: NewGaussianInt(big.NewInt(1), big.NewInt(0)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := new(GaussianInt)
+			got := g.GCD(tt.args.a, tt.args.b)
+			if !got.Equals(tt.want) || !g.Equals(tt.want) {
+				t.Errorf("GCD() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGaussianInt_ContinuedFraction(t *testing.T) {
+	g := new(GaussianInt)
+	got := g.ContinuedFraction(
+		NewGaussianInt(big.NewInt(5), big.NewInt(6)),
+		NewGaussianInt(big.NewInt(1), big.NewInt(2)),
+	)
+	want := []*GaussianInt{
+		NewGaussianInt(big.NewInt(3), big.NewInt(-1)),
+		NewGaussianInt(big.NewInt(2), big.NewInt(-1)),
+	}
+	if len(got) != len(want) {
+		t.Fatalf("ContinuedFraction() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if !got[i].Equals(want[i]) {
+			t.Fatalf("ContinuedFraction() = %v, want %v", got, want)
+		}
+	}
+	if !g.Equals(ImagUnit()) {
+		t.Errorf("ContinuedFraction() remainder = %v, want %v", g, ImagUnit())
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for n := 0; n < 1000; n++ {
+		a := NewGaussianInt(big.NewInt(r.Int63()-r.Int63()), big.NewInt(r.Int63()-r.Int63()))
+		b := NewGaussianInt(big.NewInt(r.Int63()-r.Int63()), big.NewInt(r.Int63()-r.Int63()))
+		if b.IsZero() {
+			continue
+		}
+		// rebuild a/b = p/q from the convergents p_k = q_k p_{k-1} + p_{k-2}, q_k = q_k q_{k-1} + q_{k-2}
+		pPrev, p := NewGaussianInt(big0, big0), One()
+		qPrev, q := One(), NewGaussianInt(big0, big0)
+		for _, quotient := range g.ContinuedFraction(a, b) {
+			pPrev, p = p, new(GaussianInt).Add(new(GaussianInt).Prod(quotient, p), pPrev)
+			qPrev, q = q, new(GaussianInt).Add(new(GaussianInt).Prod(quotient, q), qPrev)
+		}
+		lhs := new(GaussianInt).Prod(a, q)
+		rhs := new(GaussianInt).Prod(b, p)
+		if !lhs.Equals(rhs) {
+			t.Fatalf("ContinuedFraction(%v, %v) convergent = %v/%v, want a/b", a, b, p, q)
+		}
+	}
+
+	if got := g.ContinuedFraction(One(), NewGaussianInt(big0, big0)); len(got) != 0 {
+		t.Errorf("ContinuedFraction(1, 0) = %v, want empty", got)
+	}
+}
+
+func TestGaussianInt_Append(t *testing.T) {
+	buf := []byte("g = ")
+	g := NewGaussianInt(big.NewInt(-3), big.NewInt(4))
+	if got := string(g.Append(buf)); got != "g = -3+4i" {
+		t.Errorf("Append() = %v, want %v", got, "g = -3+4i")
+	}
+}
+
+func TestGaussianInt_ConjInPlace(t *testing.T) {
+	g := NewGaussianInt(big.NewInt(3), big.NewInt(-4))
+	if got := g.Copy().ConjInPlace(); !got.Equals(NewGaussianInt(big.NewInt(3), big.NewInt(4))) {
+		t.Errorf("ConjInPlace() = %v, want %v", got, "3+4i")
+	}
+	if got := g.Copy().ConjInPlace().ConjInPlace(); !got.Equals(g) {
+		t.Errorf("ConjInPlace().ConjInPlace() = %v, want %v", got, g)
+	}
+	if got := new(GaussianInt).ConjInPlace(); !got.IsZero() || got.R == nil || got.I == nil {
+		t.Errorf("ConjInPlace() = %v, want initialized 0", got)
+	}
+}
+
+func TestGaussianInt_Associates(t *testing.T) {
+	tests := []*GaussianInt{
+		NewGaussianInt(big.NewInt(3), big.NewInt(4)),
+		NewGaussianInt(big.NewInt(-2), big.NewInt(0)),
+		NewGaussianInt(big.NewInt(0), big.NewInt(7)),
+		NewGaussianInt(big.NewInt(-1), big.NewInt(-5)),
+	}
+	for _, g := range tests {
+		t.Run(g.String(), func(t *testing.T) {
+			associates := g.Associates()
+			if len(associates) != 4 {
+				t.Fatalf("Associates() = %v, want 4 associates", associates)
+			}
+			canonical := 0
+			for _, a := range associates {
+				if a.CmpNorm(g) != 0 {
+					t.Errorf("Associates() contains %v with norm %v, want %v", a, a.Norm(), g.Norm())
+				}
+				if a.R.Sign() > 0 && a.I.Sign() >= 0 {
+					canonical++
+				}
+			}
+			if canonical != 1 {
+				t.Errorf("Associates() = %v, %d associates in the canonical quadrant, want 1", associates, canonical)
+			}
+		})
+	}
+}
+
+func TestGaussianInt_IsOne(t *testing.T) {
+	tests := []struct {
+		name string
+		g    *GaussianInt
+		want bool
+	}{
+		{
+			name: "test_1",
+			g:    NewGaussianInt(big.NewInt(1), big.NewInt(0)),
+			want: true,
+		},
+		{
+			name: "test_2",
+			g:    NewGaussianInt(big.NewInt(2), big.NewInt(0)),
+			want: false,
+		},
+		{
+			name: "test_5",
+			g:    NewGaussianInt(big.NewInt(5), big.NewInt(0)),
+			want: false,
+		},
+		{
+			name: "test_1+i",
+			g:    NewGaussianInt(big.NewInt(1), big.NewInt(1)),
+			want: false,
+		},
+		{
+			name: "test_-1",
+			g:    NewGaussianInt(big.NewInt(-1), big.NewInt(0)),
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.g.IsOne(); got != tt.want {
+				t.Errorf("IsOne() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGaussianInt_DivCheck(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for n := 0; n < 1000; n++ {
+		a := NewGaussianInt(
+			new(big.Int).Rand(r, new(big.Int).Lsh(big.NewInt(1), 256)),
+			new(big.Int).Rand(r, new(big.Int).Lsh(big.NewInt(1), 256)),
+		)
+		b := NewGaussianInt(big.NewInt(r.Int63()-r.Int63()), big.NewInt(r.Int63()-r.Int63()))
+		if b.IsZero() {
+			continue
+		}
+		remainder := new(GaussianInt)
+		quotient, ok := remainder.DivCheck(a, b)
+		if !ok {
+			t.Fatalf("DivCheck(%v, %v) remainder = %v, norm not smaller than divisor", a, b, remainder)
+		}
+		got := new(GaussianInt).Prod(quotient, b)
+		if got.Add(got, remainder); !got.Equals(a) {
+			t.Fatalf("DivCheck(%v, %v) = %v, remainder %v, quotient * b + remainder = %v", a, b, quotient, remainder, got)
+		}
+	}
+}
+
+func TestGaussianInt_ProdThreeMul(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	bound := new(big.Int).Lsh(big1, 2*prodThreeMulThreshold)
+	randInt := func() *big.Int {
+		x := new(big.Int).Rand(r, bound)
+		if r.Intn(2) == 0 {
+			x.Neg(x)
+		}
+		return x
+	}
+	for n := 0; n < 100; n++ {
+		a, b := NewGaussianInt(randInt(), randInt()), NewGaussianInt(randInt(), randInt())
+		want := NewGaussianInt(
+			new(big.Int).Sub(new(big.Int).Mul(a.R, b.R), new(big.Int).Mul(a.I, b.I)),
+			new(big.Int).Add(new(big.Int).Mul(a.R, b.I), new(big.Int).Mul(a.I, b.R)),
+		)
+		if got := new(GaussianInt).prodThreeMul(a, b); !got.Equals(want) {
+			t.Fatalf("prodThreeMul(%v, %v) = %v, want %v", a, b, got, want)
+		}
+		if got := new(GaussianInt).Prod(a, b); !got.Equals(want) {
+			t.Fatalf("Prod(%v, %v) = %v, want %v", a, b, got, want)
+		}
+	}
+}
+
+func TestGaussianInt_DivRemainderBound(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	bound := new(big.Int).Lsh(big1, 200)
+	for n := 0; n < 2000; n++ {
+		a := NewGaussianInt(new(big.Int).Rand(r, bound), new(big.Int).Rand(r, bound))
+		b := NewGaussianInt(big.NewInt(r.Int63n(2001)-1000), big.NewInt(r.Int63n(2001)-1000))
+		if r.Intn(2) == 0 {
+			a.R.Neg(a.R)
+		}
+		if b.IsZero() {
+			continue
+		}
+		if n%2 == 0 {
+			// a = q * b + (b / 2 rounded), to hit ties and near-ties of the rounding
+			a.Prod(a, b)
+			a.R.Add(a.R, new(big.Int).Quo(b.R, big2))
+			a.I.Add(a.I, new(big.Int).Quo(b.I, big2))
+		}
+		remainder := new(GaussianInt)
+		quotient := remainder.Div(a, b)
+		if got := new(big.Int).Lsh(remainder.Norm(), 1); got.Cmp(b.Norm()) > 0 {
+			t.Fatalf("Div(%v, %v) remainder = %v, norm exceeds N(b) / 2", a, b, remainder)
+		}
+		if got := new(GaussianInt).Prod(quotient, b); !got.Add(got, remainder).Equals(a) {
+			t.Fatalf("Div(%v, %v) = %v, remainder %v, quotient * b + remainder = %v", a, b, quotient, remainder, got)
+		}
+	}
+
+	// (101+101i) / 200 = 0.505+0.505i must round up in both parts to meet the bound
+	quotient := new(GaussianInt).Div(NewGaussianInt(big.NewInt(101), big.NewInt(101)), NewGaussianInt(big.NewInt(200), big.NewInt(0)))
+	if !quotient.Equals(NewGaussianInt(big.NewInt(1), big.NewInt(1))) {
+		t.Errorf("Div(101+101i, 200) = %v, want 1+i", quotient)
+	}
+
+	// (1+i) / 2 is a tie in both parts, which is rounded toward zero
+	remainder := new(GaussianInt)
+	quotient = remainder.Div(NewGaussianInt(big.NewInt(1), big.NewInt(1)), NewGaussianInt(big.NewInt(2), big.NewInt(0)))
+	if !quotient.IsZero() || !remainder.Equals(NewGaussianInt(big.NewInt(1), big.NewInt(1))) {
+		t.Errorf("Div(1+i, 2) = %v, remainder %v, want 0, remainder 1+i", quotient, remainder)
+	}
+}
+
+func TestGaussianInt_DivExactRat(t *testing.T) {
+	type args struct {
+		a *GaussianInt
+		b *GaussianInt
+	}
+	tests := []struct {
+		name   string
+		args   args
+		wantRe *big.Rat
+		wantIm *big.Rat
+	}{
+		{
+			name: "test_(1+i)/(1-i)",
+			args: args{
+				a: NewGaussianInt(big.NewInt(1), big.NewInt(1)),
+				b: NewGaussianInt(big.NewInt(1), big.NewInt(-1)),
+			},
+			wantRe: big.NewRat(0, 1),
+			wantIm: big.NewRat(1, 1),
+		},
+		{
+			name: "test_(1+i)/(2+2i)",
+			args: args{
+				a: NewGaussianInt(big.NewInt(1), big.NewInt(1)),
+				b: NewGaussianInt(big.NewInt(2), big.NewInt(2)),
+			},
+			wantRe: big.NewRat(1, 2),
+			wantIm: big.NewRat(0, 1),
+		},
+		{
+			name: "test_(7+3i)/(2-i)",
+			args: args{
+				a: NewGaussianInt(big.NewInt(7), big.NewInt(3)),
+				b: NewGaussianInt(big.NewInt(2), big.NewInt(-1)),
+			},
+			wantRe: big.NewRat(11, 5),
+			wantIm: big.NewRat(13, 5),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRe, gotIm := new(GaussianInt).DivExactRat(tt.args.a, tt.args.b)
+			if gotRe.Cmp(tt.wantRe) != 0 || gotIm.Cmp(tt.wantIm) != 0 {
+				t.Errorf("DivExactRat() = %v, %v, want %v, %v", gotRe, gotIm, tt.wantRe, tt.wantIm)
+			}
+		})
+	}
+}
+
+func TestGaussianInt_BitLen(t *testing.T) {
+	tests := []struct {
+		name string
+		g    *GaussianInt
+		want int
+	}{
+		{
+			name: "test_0",
+			g:    NewGaussianInt(big.NewInt(0), big.NewInt(0)),
+			want: 0,
+		},
+		{
+			name: "test_3-255i",
+			g:    NewGaussianInt(big.NewInt(3), big.NewInt(-255)),
+			want: 8,
+		},
+		{
+			name: "test_-256+i",
+			g:    NewGaussianInt(big.NewInt(-256), big.NewInt(1)),
+			want: 9,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.g.BitLen(); got != tt.want {
+				t.Errorf("BitLen() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEqualGaussian(t *testing.T) {
+	tests := []struct {
+		name string
+		a    *GaussianInt
+		b    *GaussianInt
+		want bool
+	}{
+		{"test_nil_nil", nil, nil, true},
+		{"test_nil_0", nil, NewGaussianInt(big.NewInt(0), big.NewInt(0)), false},
+		{"test_0_nil", NewGaussianInt(big.NewInt(0), big.NewInt(0)), nil, false},
+		{"test_zero_value_0", &GaussianInt{}, NewGaussianInt(big.NewInt(0), big.NewInt(0)), true},
+		{"test_0_zero_value", NewGaussianInt(big.NewInt(0), big.NewInt(0)), &GaussianInt{}, true},
+		{"test_zero_value_zero_value", &GaussianInt{}, &GaussianInt{}, true},
+		{"test_zero_value_i", &GaussianInt{}, NewGaussianInt(big.NewInt(0), big.NewInt(1)), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EqualGaussian(tt.a, tt.b); got != tt.want {
+				t.Errorf("EqualGaussian() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGaussianInt_Reset(t *testing.T) {
+	g := NewGaussianInt(big.NewInt(3), big.NewInt(-4))
+	r, i := g.R, g.I
+	if got := g.Reset(); !got.IsZero() || got != g {
+		t.Errorf("Reset() = %v, want 0", got)
+	}
+	if g.R != r || g.I != i {
+		t.Errorf("Reset() reallocated the underlying big integers")
+	}
+	if got := new(GaussianInt).Reset(); !got.IsZero() {
+		t.Errorf("Reset() = %v, want 0", got)
+	}
+}
+
+func TestGaussianInt_IsAssociate(t *testing.T) {
+	tests := []struct {
+		name string
+		g    *GaussianInt
+		a    *GaussianInt
+		want bool
+	}{
+		{"test_(3+4i)_(4-3i)", NewGaussianInt(big.NewInt(3), big.NewInt(4)), NewGaussianInt(big.NewInt(4), big.NewInt(-3)), true},
+		{"test_(3+4i)_(-4+3i)", NewGaussianInt(big.NewInt(3), big.NewInt(4)), NewGaussianInt(big.NewInt(-4), big.NewInt(3)), true},
+		{"test_(3+4i)_(-3-4i)", NewGaussianInt(big.NewInt(3), big.NewInt(4)), NewGaussianInt(big.NewInt(-3), big.NewInt(-4)), true},
+		{"test_(3+4i)_(3+4i)", NewGaussianInt(big.NewInt(3), big.NewInt(4)), NewGaussianInt(big.NewInt(3), big.NewInt(4)), true},
+		{"test_(3+4i)_(3-4i)", NewGaussianInt(big.NewInt(3), big.NewInt(4)), NewGaussianInt(big.NewInt(3), big.NewInt(-4)), false},
+		{"test_(3+4i)_(5)", NewGaussianInt(big.NewInt(3), big.NewInt(4)), NewGaussianInt(big.NewInt(5), big.NewInt(0)), false},
+		{"test_(0)_(0)", NewGaussianInt(big.NewInt(0), big.NewInt(0)), NewGaussianInt(big.NewInt(0), big.NewInt(0)), true},
+		{"test_(1)_(-i)", One(), NewGaussianInt(big.NewInt(0), big.NewInt(-1)), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.g.IsAssociate(tt.a); got != tt.want {
+				t.Errorf("IsAssociate() = %v, want %v", got, tt.want)
+			}
+			if got := tt.a.IsAssociate(tt.g); got != tt.want {
+				t.Errorf("IsAssociate() reversed = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGaussianInt_GCDSteps(t *testing.T) {
+	tests := []struct {
+		name           string
+		a              *GaussianInt
+		b              *GaussianInt
+		wantRemainders []*GaussianInt
+		wantGCD        *GaussianInt
+	}{
+		{
+			name: "test_(6+3i)_(5i)",
+			a:    NewGaussianInt(big.NewInt(6), big.NewInt(3)),
+			b:    NewGaussianInt(big.NewInt(0), big.NewInt(5)),
+			wantRemainders: []*GaussianInt{
+				NewGaussianInt(big.NewInt(1), big.NewInt(-2)),
+				NewGaussianInt(big.NewInt(0), big.NewInt(0)),
+			},
+			wantGCD: NewGaussianInt(big.NewInt(2), big.NewInt(1)),
+		},
+		{
+			name: "test_(1+2i)_(5+6i)",
+			a:    NewGaussianInt(big.NewInt(1), big.NewInt(2)),
+			b:    NewGaussianInt(big.NewInt(5), big.NewInt(6)),
+			wantRemainders: []*GaussianInt{
+				NewGaussianInt(big.NewInt(0), big.NewInt(1)),
+				NewGaussianInt(big.NewInt(0), big.NewInt(0)),
+			},
+			wantGCD: One(),
+		},
+		{
+			name:           "test_(-3+4i)_(0)",
+			a:              NewGaussianInt(big.NewInt(-3), big.NewInt(4)),
+			b:              NewGaussianInt(big.NewInt(0), big.NewInt(0)),
+			wantRemainders: nil,
+			wantGCD:        NewGaussianInt(big.NewInt(4), big.NewInt(3)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := new(GaussianInt)
+			remainders, gcd := g.GCDSteps(tt.a, tt.b)
+			if len(remainders) != len(tt.wantRemainders) {
+				t.Fatalf("GCDSteps() remainders = %v, want %v", remainders, tt.wantRemainders)
+			}
+			for i := range remainders {
+				if !remainders[i].Equals(tt.wantRemainders[i]) {
+					t.Fatalf("GCDSteps() remainders = %v, want %v", remainders, tt.wantRemainders)
+				}
+			}
+			if !gcd.Equals(tt.wantGCD) || !g.Equals(tt.wantGCD) {
+				t.Errorf("GCDSteps() gcd = %v, want %v", gcd, tt.wantGCD)
+			}
+		})
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for n := 0; n < 200; n++ {
+		a := NewGaussianInt(big.NewInt(r.Int63()-r.Int63()), big.NewInt(r.Int63()-r.Int63()))
+		b := NewGaussianInt(big.NewInt(r.Int63()-r.Int63()), big.NewInt(r.Int63()-r.Int63()))
+		remainders, gcd := new(GaussianInt).GCDSteps(a, b)
+		if want := new(GaussianInt).GCD(a, b); !gcd.Equals(want) {
+			t.Fatalf("GCDSteps(%v, %v) gcd = %v, want %v", a, b, gcd, want)
+		}
+		prev := a.Norm()
+		if bNorm := b.Norm(); bNorm.Cmp(prev) < 0 {
+			prev = bNorm
+		}
+		for _, remainder := range remainders {
+			if remainder.Norm().Cmp(prev) >= 0 {
+				t.Fatalf("GCDSteps(%v, %v) remainders = %v, norms not decreasing", a, b, remainders)
+			}
+			prev = remainder.Norm()
+		}
+	}
+}
+
+func TestCloneGaussianInts(t *testing.T) {
+	xs := []*GaussianInt{NewGaussianInt(big.NewInt(1), big.NewInt(2)), nil, NewGaussianInt(big.NewInt(-3), big.NewInt(0))}
+	got := CloneGaussianInts(xs)
+	if len(got) != len(xs) || got[1] != nil {
+		t.Fatalf("CloneGaussianInts() = %v, want %v", got, xs)
+	}
+	for _, i := range []int{0, 2} {
+		if got[i] == xs[i] || !got[i].Equals(xs[i]) {
+			t.Fatalf("CloneGaussianInts() = %v, want deep copies of %v", got, xs)
+		}
+	}
+	got[0].R.SetInt64(7)
+	if xs[0].R.Int64() != 1 {
+		t.Errorf("CloneGaussianInts() shares big integers with the original")
+	}
+	if CloneGaussianInts(nil) != nil {
+		t.Errorf("CloneGaussianInts(nil) != nil")
+	}
+}
+
+func TestGaussianInt_Mul(t *testing.T) {
+	a := NewGaussianInt(big.NewInt(3), big.NewInt(-2))
+	b := NewGaussianInt(big.NewInt(-1), big.NewInt(5))
+	g := new(GaussianInt)
+	if got, want := g.Mul(a, b), new(GaussianInt).Prod(a, b); got != g || !got.Equals(want) {
+		t.Errorf("Mul() = %v, want %v", got, want)
+	}
+}
+
+func TestGaussianInt_Clone(t *testing.T) {
+	g := NewGaussianInt(big.NewInt(3), big.NewInt(-4))
+	got := g.Clone()
+	if got == g || got.R == g.R || got.I == g.I || !got.Equals(g) {
+		t.Errorf("Clone() = %v, want a deep copy of %v", got, g)
+	}
+	got = new(GaussianInt).Clone()
+	if got.R == nil || got.I == nil || !got.IsZero() {
+		t.Errorf("Clone() of the zero value = %v, want 0", got)
+	}
+}
+
+func TestGaussianInt_CopyNilParts(t *testing.T) {
+	tests := []struct {
+		name string
+		g    *GaussianInt
+		want *GaussianInt
+	}{
+		{"test_zero_value", &GaussianInt{}, NewGaussianInt(big.NewInt(0), big.NewInt(0))},
+		{"test_nil_imag", &GaussianInt{R: big.NewInt(3)}, NewGaussianInt(big.NewInt(3), big.NewInt(0))},
+		{"test_nil_real", &GaussianInt{I: big.NewInt(-4)}, NewGaussianInt(big.NewInt(0), big.NewInt(-4))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.g.Copy(); got.R == nil || got.I == nil || !got.Equals(tt.want) {
+				t.Errorf("Copy() = %v, want %v", got, tt.want)
+			}
+			if got := NewGaussianInt(big.NewInt(7), big.NewInt(7)).Set(tt.g); !got.Equals(tt.want) {
+				t.Errorf("Set() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGaussianInt_GCDWithSteps(t *testing.T) {
+	tests := []struct {
+		name      string
+		a         *GaussianInt
+		b         *GaussianInt
+		want      *GaussianInt
+		wantSteps int
+	}{
+		{"test_unit", NewGaussianInt(big.NewInt(5), big.NewInt(3)), NewGaussianInt(big.NewInt(0), big.NewInt(1)), One(), 0},
+		{"test_3_2", NewGaussianInt(big.NewInt(3), big.NewInt(0)), NewGaussianInt(big.NewInt(2), big.NewInt(0)), One(), 1},
+		{"test_swapped", NewGaussianInt(big.NewInt(2), big.NewInt(0)), NewGaussianInt(big.NewInt(3), big.NewInt(0)), One(), 1},
+		{"test_fibonacci", NewGaussianInt(big.NewInt(13), big.NewInt(0)), NewGaussianInt(big.NewInt(8), big.NewInt(0)), One(), 2},
+		{"test_coprime_complex", NewGaussianInt(big.NewInt(13), big.NewInt(8)), NewGaussianInt(big.NewInt(3), big.NewInt(5)), One(), 2},
+		{"test_divisor", NewGaussianInt(big.NewInt(10), big.NewInt(0)), NewGaussianInt(big.NewInt(2), big.NewInt(1)), NewGaussianInt(big.NewInt(2), big.NewInt(1)), 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := new(GaussianInt)
+			got, steps := g.GCDWithSteps(tt.a, tt.b)
+			if !got.Equals(tt.want) || !g.Equals(tt.want) {
+				t.Errorf("GCDWithSteps() = %v, want %v", got, tt.want)
+			}
+			if steps != tt.wantSteps {
+				t.Errorf("GCDWithSteps() steps = %d, want %d", steps, tt.wantSteps)
+			}
+		})
+	}
+
+	// GCDSteps does not stop at a unit remainder, so it takes one more division for coprime inputs
+	r := rand.New(rand.NewSource(1))
+	for n := 0; n < 1000; n++ {
+		a := NewGaussianInt(big.NewInt(r.Int63n(2001)-1000), big.NewInt(r.Int63n(2001)-1000))
+		b := NewGaussianInt(big.NewInt(r.Int63n(2001)-1000), big.NewInt(r.Int63n(2001)-1000))
+		if a.IsZero() || b.IsZero() {
+			continue
+		}
+		gcd, steps := new(GaussianInt).GCDWithSteps(a, b)
+		remainders, _ := new(GaussianInt).GCDSteps(a, b)
+		want := len(remainders)
+		if gcd.IsUnit() {
+			want--
+		}
+		if steps != want {
+			t.Fatalf("GCDWithSteps(%v, %v) steps = %d, GCDSteps takes %d, want %d", a, b, steps, len(remainders), want)
+		}
+	}
+}
+
+func TestNormOfProduct(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for n := 0; n < 1000; n++ {
+		a := NewGaussianInt(big.NewInt(r.Int63()-r.Int63()), big.NewInt(r.Int63()-r.Int63()))
+		b := NewGaussianInt(big.NewInt(r.Int63()-r.Int63()), big.NewInt(r.Int63()-r.Int63()))
+		want := new(GaussianInt).Prod(a, b).Norm()
+		if got := NormOfProduct(a, b); got.Cmp(want) != 0 {
+			t.Fatalf("NormOfProduct(%v, %v) = %v, want %v", a, b, got, want)
+		}
+	}
+}
+
+func TestGaussianInt_Powers(t *testing.T) {
+	g := NewGaussianInt(big.NewInt(1), big.NewInt(1))
+	want := []*GaussianInt{
+		NewGaussianInt(big.NewInt(1), big.NewInt(0)),
+		NewGaussianInt(big.NewInt(1), big.NewInt(1)),
+		NewGaussianInt(big.NewInt(0), big.NewInt(2)),
+		NewGaussianInt(big.NewInt(-2), big.NewInt(2)),
+		NewGaussianInt(big.NewInt(-4), big.NewInt(0)),
+	}
+	got := g.Powers(4)
+	if len(got) != len(want) {
+		t.Fatalf("Powers() returned %d powers, want %d", len(got), len(want))
+	}
+	manual := One()
+	for k := range want {
+		if !got[k].Equals(want[k]) || !got[k].Equals(manual) {
+			t.Errorf("Powers()[%d] = %v, want %v", k, got[k], want[k])
+		}
+		manual = new(GaussianInt).Prod(manual, g)
+	}
+	got[1].R.SetInt64(7)
+	if !g.Equals(NewGaussianInt(big.NewInt(1), big.NewInt(1))) {
+		t.Errorf("Powers() shares big integers with the base")
+	}
+	if got := g.Powers(0); len(got) != 1 || !got[0].IsOne() {
+		t.Errorf("Powers(0) = %v, want [1]", got)
+	}
+	if got := g.Powers(-1); got == nil || len(got) != 0 {
+		t.Errorf("Powers(-1) = %v, want []", got)
+	}
+}
+
+func TestGaussianInt_IsReal(t *testing.T) {
+	tests := []struct {
+		name          string
+		g             *GaussianInt
+		wantReal      bool
+		wantImaginary bool
+	}{
+		{"test_zero", NewGaussianInt(big.NewInt(0), big.NewInt(0)), true, false},
+		{"test_zero_value", &GaussianInt{}, true, false},
+		{"test_real", NewGaussianInt(big.NewInt(-7), big.NewInt(0)), true, false},
+		{"test_imaginary", NewGaussianInt(big.NewInt(0), big.NewInt(3)), false, true},
+		{"test_nil_real", &GaussianInt{I: big.NewInt(-1)}, false, true},
+		{"test_nil_imag", &GaussianInt{R: big.NewInt(5)}, true, false},
+		{"test_complex", NewGaussianInt(big.NewInt(1), big.NewInt(1)), false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.g.IsReal(); got != tt.wantReal {
+				t.Errorf("IsReal() = %v, want %v", got, tt.wantReal)
+			}
+			if got := tt.g.IsImaginary(); got != tt.wantImaginary {
+				t.Errorf("IsImaginary() = %v, want %v", got, tt.wantImaginary)
+			}
+		})
+	}
+}
+
+func TestGaussianInt_ScaledInverse(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for n := 0; n < 1000; n++ {
+		g := NewGaussianInt(big.NewInt(r.Int63()-r.Int63()), big.NewInt(r.Int63()-r.Int63()))
+		conj, norm := g.ScaledInverse()
+		got := new(GaussianInt).Prod(g, conj)
+		if got.R.Cmp(norm) != 0 || got.I.Sign() != 0 || norm.Cmp(g.Norm()) != 0 {
+			t.Fatalf("%v * ScaledInverse() = %v, want %v+0i", g, got, norm)
+		}
+	}
+	conj, norm := NewGaussianInt(big.NewInt(3), big.NewInt(4)).ScaledInverse()
+	if !conj.Equals(NewGaussianInt(big.NewInt(3), big.NewInt(-4))) || norm.Int64() != 25 {
+		t.Errorf("ScaledInverse() = %v, %v, want 3-4i, 25", conj, norm)
+	}
+}
+
+func TestGaussianInt_IsCoprime(t *testing.T) {
+	tests := []struct {
+		name string
+		g    *GaussianInt
+		a    *GaussianInt
+		want bool
+	}{
+		{"test_conjugate_primes", NewGaussianInt(big.NewInt(2), big.NewInt(1)), NewGaussianInt(big.NewInt(2), big.NewInt(-1)), true},
+		{"test_ramified", NewGaussianInt(big.NewInt(1), big.NewInt(1)), NewGaussianInt(big.NewInt(2), big.NewInt(0)), false},
+		{"test_associates", NewGaussianInt(big.NewInt(2), big.NewInt(1)), NewGaussianInt(big.NewInt(-1), big.NewInt(2)), false},
+		{"test_rational", NewGaussianInt(big.NewInt(3), big.NewInt(0)), NewGaussianInt(big.NewInt(7), big.NewInt(0)), true},
+		{"test_unit", NewGaussianInt(big.NewInt(0), big.NewInt(-1)), NewGaussianInt(big.NewInt(12), big.NewInt(5)), true},
+		{"test_zero_and_unit", NewGaussianInt(big.NewInt(0), big.NewInt(0)), NewGaussianInt(big.NewInt(-1), big.NewInt(0)), true},
+		{"test_zero_and_non_unit", NewGaussianInt(big.NewInt(3), big.NewInt(0)), NewGaussianInt(big.NewInt(0), big.NewInt(0)), false},
+		{"test_zeros", NewGaussianInt(big.NewInt(0), big.NewInt(0)), NewGaussianInt(big.NewInt(0), big.NewInt(0)), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.g.IsCoprime(tt.a); got != tt.want {
+				t.Errorf("IsCoprime() = %v, want %v", got, tt.want)
+			}
+			if got := tt.a.IsCoprime(tt.g); got != tt.want {
+				t.Errorf("IsCoprime() swapped = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVerifyEuclideanProperty(t *testing.T) {
+	// rounding the quotient to the nearest Gaussian integer bounds the remainder by 2 * N(r) <= N(b),
+	// tighter than the N(r) < N(b) that VerifyEuclideanProperty checks
+	withinHalf := func(a, b *GaussianInt) bool {
+		rem := new(GaussianInt)
+		rem.Div(a, b)
+		return new(big.Int).Lsh(rem.Norm(), 1).Cmp(b.Norm()) <= 0
+	}
+	// rounding through floating point used to leave the remainder 101+101i here, with 2 * N(r) = 40804 > N(b) = 40000
+	a, b := NewGaussianInt(big.NewInt(101), big.NewInt(101)), NewGaussianInt(big.NewInt(200), big.NewInt(0))
+	if !VerifyEuclideanProperty(a, b) || !withinHalf(a, b) {
+		t.Errorf("VerifyEuclideanProperty(101+101i, 200) = false or 2 * N(r) > N(b)")
+	}
+	if VerifyEuclideanProperty(One(), NewGaussianInt(big.NewInt(0), big.NewInt(0))) {
+		t.Errorf("VerifyEuclideanProperty(1, 0) = true")
+	}
+	r := rand.New(rand.NewSource(1))
+	randInt := func(bits int) *big.Int {
+		x := new(big.Int).Rand(r, new(big.Int).Lsh(big1, uint(bits)))
+		if r.Intn(2) == 0 {
+			x.Neg(x)
+		}
+		return x
+	}
+	for _, bits := range []int{8, 64, 512, 2048, 4096} {
+		for n := 0; n < 200; n++ {
+			a := NewGaussianInt(randInt(2*bits), randInt(2*bits))
+			b := NewGaussianInt(randInt(bits), randInt(bits))
+			if r.Intn(4) == 0 {
+				// a divisor close to a rational integer stresses the rounding of each part
+				b.I.Rsh(b.I, uint(bits-1))
+			}
+			if b.IsZero() {
+				continue
+			}
+			if !VerifyEuclideanProperty(a, b) || !withinHalf(a, b) {
+				t.Fatalf("VerifyEuclideanProperty(%v, %v) = false or 2 * N(r) > N(b)", a, b)
+			}
+		}
+	}
+}
+
+func TestGaussianInt_AddMul(t *testing.T) {
+	tests := []struct {
+		name    string
+		g       *GaussianInt
+		a       *GaussianInt
+		b       *GaussianInt
+		wantAdd *GaussianInt
+		wantSub *GaussianInt
+	}{
+		{
+			name:    "test_1+(2+i)(2-i)",
+			g:       NewGaussianInt(big.NewInt(1), big.NewInt(0)),
+			a:       NewGaussianInt(big.NewInt(2), big.NewInt(1)),
+			b:       NewGaussianInt(big.NewInt(2), big.NewInt(-1)),
+			wantAdd: NewGaussianInt(big.NewInt(6), big.NewInt(0)),
+			wantSub: NewGaussianInt(big.NewInt(-4), big.NewInt(0)),
+		},
+		{
+			name:    "test_(3-2i)+(1+i)(4+5i)",
+			g:       NewGaussianInt(big.NewInt(3), big.NewInt(-2)),
+			a:       NewGaussianInt(big.NewInt(1), big.NewInt(1)),
+			b:       NewGaussianInt(big.NewInt(4), big.NewInt(5)),
+			wantAdd: NewGaussianInt(big.NewInt(2), big.NewInt(7)),
+			wantSub: NewGaussianInt(big.NewInt(4), big.NewInt(-11)),
+		},
+		{
+			name:    "test_zero_value",
+			g:       new(GaussianInt),
+			a:       NewGaussianInt(big.NewInt(0), big.NewInt(1)),
+			b:       NewGaussianInt(big.NewInt(0), big.NewInt(1)),
+			wantAdd: NewGaussianInt(big.NewInt(-1), big.NewInt(0)),
+			wantSub: NewGaussianInt(big.NewInt(1), big.NewInt(0)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.g.Copy().AddMul(tt.a, tt.b); !got.Equals(tt.wantAdd) {
+				t.Errorf("AddMul() = %v, want %v", got, tt.wantAdd)
+			}
+			if got := tt.g.Copy().SubMul(tt.a, tt.b); !got.Equals(tt.wantSub) {
+				t.Errorf("SubMul() = %v, want %v", got, tt.wantSub)
+			}
+		})
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for n := 0; n < 1000; n++ {
+		g := NewGaussianInt(big.NewInt(r.Int63()-r.Int63()), big.NewInt(r.Int63()-r.Int63()))
+		a := NewGaussianInt(big.NewInt(r.Int63()-r.Int63()), big.NewInt(r.Int63()-r.Int63()))
+		b := NewGaussianInt(big.NewInt(r.Int63()-r.Int63()), big.NewInt(r.Int63()-r.Int63()))
+		prod := new(GaussianInt).Prod(a, b)
+		if got, want := g.Copy().AddMul(a, b), new(GaussianInt).Add(g, prod); !got.Equals(want) {
+			t.Fatalf("(%v).AddMul(%v, %v) = %v, want %v", g, a, b, got, want)
+		}
+		if got, want := g.Copy().SubMul(a, b), new(GaussianInt).Sub(g, prod); !got.Equals(want) {
+			t.Fatalf("(%v).SubMul(%v, %v) = %v, want %v", g, a, b, got, want)
+		}
+		// aliasing the receiver with both operands computes g + g^2
+		want := new(GaussianInt).Add(g, new(GaussianInt).Prod(g, g))
+		if got := g.Copy(); !got.AddMul(got, got).Equals(want) {
+			t.Fatalf("(%v).AddMul(g, g) = %v, want %v", g, got, want)
+		}
+	}
+}
+
+func TestGaussianInt_RealImag(t *testing.T) {
+	tests := []struct {
+		name     string
+		g        *GaussianInt
+		wantReal *big.Int
+		wantImag *big.Int
+	}{
+		{"test_3-4i", NewGaussianInt(big.NewInt(3), big.NewInt(-4)), big.NewInt(3), big.NewInt(-4)},
+		{"test_zero_value", new(GaussianInt), big.NewInt(0), big.NewInt(0)},
+		{"test_nil_real", &GaussianInt{I: big.NewInt(7)}, big.NewInt(0), big.NewInt(7)},
+		{"test_nil_imag", &GaussianInt{R: big.NewInt(-2)}, big.NewInt(-2), big.NewInt(0)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotReal, gotImag := tt.g.Real(), tt.g.Imag()
+			if gotReal.Cmp(tt.wantReal) != 0 || gotImag.Cmp(tt.wantImag) != 0 {
+				t.Fatalf("Real(), Imag() = %v, %v, want %v, %v", gotReal, gotImag, tt.wantReal, tt.wantImag)
+			}
+			// the results are copies, so modifying them leaves the Gaussian integer unchanged
+			before := tt.g.Copy()
+			gotReal.Add(gotReal, big1)
+			gotImag.Add(gotImag, big1)
+			if after := tt.g.Copy(); !after.Equals(before) {
+				t.Errorf("modifying Real() and Imag() changed the Gaussian integer from %v to %v", before, after)
+			}
+		})
+	}
+}
@@ -0,0 +1,183 @@
+// MIT License
+//
+// Copyright (c) 2022 Tommy TIAN
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package complex
+
+import "math/big"
+
+// GaussianMatrix2 implements 2x2 matrices over Gaussian integers
+//
+//	| A  B |
+//	| C  D |
+type GaussianMatrix2 struct {
+	A *GaussianInt // upper left entry
+	B *GaussianInt // upper right entry
+	C *GaussianInt // lower left entry
+	D *GaussianInt // lower right entry
+}
+
+// NewGaussianMatrix2 declares a new 2x2 matrix with the given entries
+func NewGaussianMatrix2(a, b, c, d *GaussianInt) *GaussianMatrix2 {
+	return &GaussianMatrix2{
+		A: a.Copy(),
+		B: b.Copy(),
+		C: c.Copy(),
+		D: d.Copy(),
+	}
+}
+
+// IdentityMatrix2 returns a new 2x2 identity matrix
+func IdentityMatrix2() *GaussianMatrix2 {
+	return &GaussianMatrix2{
+		A: One(),
+		B: NewGaussianInt(big0, big0),
+		C: NewGaussianInt(big0, big0),
+		D: One(),
+	}
+}
+
+// Set sets the matrix to the given matrix
+func (m *GaussianMatrix2) Set(a *GaussianMatrix2) *GaussianMatrix2 {
+	if m.A == nil {
+		m.A = new(GaussianInt)
+	}
+	m.A.Set(a.A)
+	if m.B == nil {
+		m.B = new(GaussianInt)
+	}
+	m.B.Set(a.B)
+	if m.C == nil {
+		m.C = new(GaussianInt)
+	}
+	m.C.Set(a.C)
+	if m.D == nil {
+		m.D = new(GaussianInt)
+	}
+	m.D.Set(a.D)
+	return m
+}
+
+// Mul returns the product of two matrices, i.e. x * y
+func (m *GaussianMatrix2) Mul(x, y *GaussianMatrix2) *GaussianMatrix2 {
+	a := new(GaussianInt).Prod(x.A, y.A).AddMul(x.B, y.C)
+	b := new(GaussianInt).Prod(x.A, y.B).AddMul(x.B, y.D)
+	c := new(GaussianInt).Prod(x.C, y.A).AddMul(x.D, y.C)
+	d := new(GaussianInt).Prod(x.C, y.B).AddMul(x.D, y.D)
+	m.A, m.B, m.C, m.D = a, b, c, d
+	return m
+}
+
+// Det returns the determinant of the matrix, i.e. A * D - B * C
+func (m *GaussianMatrix2) Det() *GaussianInt {
+	opt := giPool.Get().(*GaussianInt)
+	defer giPool.Put(opt)
+	det := new(GaussianInt).Prod(m.A, m.D)
+	return det.Sub(det, opt.Prod(m.B, m.C))
+}
+
+// Adjugate obtains the adjugate of the original matrix
+//
+//	|  D  -B |
+//	| -C   A |
+func (m *GaussianMatrix2) Adjugate(origin *GaussianMatrix2) *GaussianMatrix2 {
+	a := origin.D.Copy()
+	b := &GaussianInt{
+		R: new(big.Int).Neg(origin.B.R),
+		I: new(big.Int).Neg(origin.B.I),
+	}
+	c := &GaussianInt{
+		R: new(big.Int).Neg(origin.C.R),
+		I: new(big.Int).Neg(origin.C.I),
+	}
+	d := origin.A.Copy()
+	m.A, m.B, m.C, m.D = a, b, c, d
+	return m
+}
+
+// Equals checks if the two matrices are equal
+func (m *GaussianMatrix2) Equals(a *GaussianMatrix2) bool {
+	return m.A.Equals(a.A) &&
+		m.B.Equals(a.B) &&
+		m.C.Equals(a.C) &&
+		m.D.Equals(a.D)
+}
+
+// GaussianRatMatrix2 implements 2x2 matrices over Gaussian rationals, e.g. the inverses of 2x2 matrices
+// over Gaussian integers
+//
+//	| A  B |
+//	| C  D |
+type GaussianRatMatrix2 struct {
+	A *GaussianRat // upper left entry
+	B *GaussianRat // upper right entry
+	C *GaussianRat // lower left entry
+	D *GaussianRat // lower right entry
+}
+
+// SetGaussianMatrix2 sets the matrix to the given matrix over Gaussian integers
+func (m *GaussianRatMatrix2) SetGaussianMatrix2(a *GaussianMatrix2) *GaussianRatMatrix2 {
+	m.A = new(GaussianRat).SetGaussianInt(a.A)
+	m.B = new(GaussianRat).SetGaussianInt(a.B)
+	m.C = new(GaussianRat).SetGaussianInt(a.C)
+	m.D = new(GaussianRat).SetGaussianInt(a.D)
+	return m
+}
+
+// Mul returns the product of two matrices, i.e. x * y
+func (m *GaussianRatMatrix2) Mul(x, y *GaussianRatMatrix2) *GaussianRatMatrix2 {
+	opt := new(GaussianRat)
+	a := new(GaussianRat).Mul(x.A, y.A)
+	a.Add(a, opt.Mul(x.B, y.C))
+	b := new(GaussianRat).Mul(x.A, y.B)
+	b.Add(b, opt.Mul(x.B, y.D))
+	c := new(GaussianRat).Mul(x.C, y.A)
+	c.Add(c, opt.Mul(x.D, y.C))
+	d := new(GaussianRat).Mul(x.C, y.B)
+	d.Add(d, opt.Mul(x.D, y.D))
+	m.A, m.B, m.C, m.D = a, b, c, d
+	return m
+}
+
+// Equals checks if the two matrices are equal
+func (m *GaussianRatMatrix2) Equals(a *GaussianRatMatrix2) bool {
+	return m.A.Equals(a.A) &&
+		m.B.Equals(a.B) &&
+		m.C.Equals(a.C) &&
+		m.D.Equals(a.D)
+}
+
+// Inverse returns the inverse of the matrix over Q(i), i.e. Adjugate(m) / Det(m), as a new matrix
+// The entries are all Gaussian integers exactly when the determinant is a unit
+// If the matrix is singular, i.e. the determinant is zero, ok is false and the result is nil
+func (m *GaussianMatrix2) Inverse() (inv *GaussianRatMatrix2, ok bool) {
+	det := m.Det()
+	if det.IsZero() {
+		return nil, false
+	}
+	detInv := new(GaussianRat).Inv(new(GaussianRat).SetGaussianInt(det))
+	inv = new(GaussianRatMatrix2).SetGaussianMatrix2(new(GaussianMatrix2).Adjugate(m))
+	inv.A.Mul(inv.A, detInv)
+	inv.B.Mul(inv.B, detInv)
+	inv.C.Mul(inv.C, detInv)
+	inv.D.Mul(inv.D, detInv)
+	return inv, true
+}
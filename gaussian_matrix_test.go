@@ -0,0 +1,150 @@
+// MIT License
+//
+// Copyright (c) 2022 Tommy TIAN
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package complex
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestGaussianMatrix2_Adjugate(t *testing.T) {
+	tests := []struct {
+		name string
+		m    *GaussianMatrix2
+	}{
+		{
+			name: "test_identity",
+			m:    IdentityMatrix2(),
+		},
+		{
+			name: "test_(1+i,2-i,3,-4i)",
+			m: NewGaussianMatrix2(
+				NewGaussianInt(big.NewInt(1), big.NewInt(1)),
+				NewGaussianInt(big.NewInt(2), big.NewInt(-1)),
+				NewGaussianInt(big.NewInt(3), big.NewInt(0)),
+				NewGaussianInt(big.NewInt(0), big.NewInt(-4)),
+			),
+		},
+		{
+			name: "test_singular",
+			m: NewGaussianMatrix2(
+				NewGaussianInt(big.NewInt(1), big.NewInt(1)),
+				NewGaussianInt(big.NewInt(2), big.NewInt(2)),
+				NewGaussianInt(big.NewInt(1), big.NewInt(0)),
+				NewGaussianInt(big.NewInt(2), big.NewInt(0)),
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			det := tt.m.Det()
+			want := &GaussianMatrix2{
+				A: det.Copy(),
+				B: NewGaussianInt(big.NewInt(0), big.NewInt(0)),
+				C: NewGaussianInt(big.NewInt(0), big.NewInt(0)),
+				D: det.Copy(),
+			}
+			adj := new(GaussianMatrix2).Adjugate(tt.m)
+			if got := new(GaussianMatrix2).Mul(tt.m, adj); !got.Equals(want) {
+				t.Errorf("A * adj(A) = %v, want %v", got, want)
+			}
+			if got := new(GaussianMatrix2).Mul(adj, tt.m); !got.Equals(want) {
+				t.Errorf("adj(A) * A = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestGaussianMatrix2_Inverse(t *testing.T) {
+	tests := []struct {
+		name        string
+		m           *GaussianMatrix2
+		wantOk      bool
+		wantInteger bool
+	}{
+		{
+			name:        "test_identity",
+			m:           IdentityMatrix2(),
+			wantOk:      true,
+			wantInteger: true,
+		},
+		{
+			name: "test_unimodular",
+			m: NewGaussianMatrix2(
+				NewGaussianInt(big.NewInt(2), big.NewInt(1)),
+				NewGaussianInt(big.NewInt(1), big.NewInt(1)),
+				NewGaussianInt(big.NewInt(1), big.NewInt(0)),
+				NewGaussianInt(big.NewInt(1), big.NewInt(0)),
+			),
+			wantOk:      true,
+			wantInteger: true,
+		},
+		{
+			name: "test_(1+i,2-i,3,-4i)",
+			m: NewGaussianMatrix2(
+				NewGaussianInt(big.NewInt(1), big.NewInt(1)),
+				NewGaussianInt(big.NewInt(2), big.NewInt(-1)),
+				NewGaussianInt(big.NewInt(3), big.NewInt(0)),
+				NewGaussianInt(big.NewInt(0), big.NewInt(-4)),
+			),
+			wantOk: true,
+		},
+		{
+			name: "test_singular",
+			m: NewGaussianMatrix2(
+				NewGaussianInt(big.NewInt(1), big.NewInt(1)),
+				NewGaussianInt(big.NewInt(2), big.NewInt(2)),
+				NewGaussianInt(big.NewInt(1), big.NewInt(0)),
+				NewGaussianInt(big.NewInt(2), big.NewInt(0)),
+			),
+		},
+	}
+	identity := new(GaussianRatMatrix2).SetGaussianMatrix2(IdentityMatrix2())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inv, ok := tt.m.Inverse()
+			if ok != tt.wantOk {
+				t.Fatalf("Inverse() ok = %v, want %v", ok, tt.wantOk)
+			}
+			if !ok {
+				return
+			}
+			m := new(GaussianRatMatrix2).SetGaussianMatrix2(tt.m)
+			if got := new(GaussianRatMatrix2).Mul(m, inv); !got.Equals(identity) {
+				t.Errorf("A * Inverse() = %v, want identity", got)
+			}
+			if got := new(GaussianRatMatrix2).Mul(inv, m); !got.Equals(identity) {
+				t.Errorf("Inverse() * A = %v, want identity", got)
+			}
+			integer := true
+			for _, entry := range []*GaussianRat{inv.A, inv.B, inv.C, inv.D} {
+				if _, isInt := entry.GaussianInt(); !isInt {
+					integer = false
+				}
+			}
+			if integer != tt.wantInteger {
+				t.Errorf("Inverse() has Gaussian integer entries = %v, want %v", integer, tt.wantInteger)
+			}
+		})
+	}
+}
@@ -0,0 +1,321 @@
+// MIT License
+//
+// Copyright (c) 2022 Tommy TIAN
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package complex
+
+import (
+	"errors"
+	"math/big"
+)
+
+const (
+	// the number of Miller-Rabin rounds used in primality tests
+	primalityRounds = 20
+)
+
+var (
+	// ErrNotPrime is returned when a rational prime is expected
+	ErrNotPrime = errors.New("not a prime")
+	// ErrInertPrime is returned for a rational prime congruent to 3 modulo 4,
+	// which stays prime in the Gaussian integers and has no Gaussian prime factor of norm p
+	ErrInertPrime = errors.New("prime congruent to 3 modulo 4 is inert in the Gaussian integers")
+)
+
+// IsPrime returns true if the Gaussian integer is a Gaussian prime
+// a + bi is a Gaussian prime if and only if either
+// a and b are both nonzero and a^2 + b^2 is a prime, or
+// one of a and b is zero and the absolute value of the other is a prime congruent to 3 modulo 4
+// The primality of rational integers is tested probabilistically with big.Int.ProbablyPrime
+func (g *GaussianInt) IsPrime() bool {
+	var abs *big.Int
+	switch {
+	case g.R.Sign() == 0:
+		abs = iPool.Get().(*big.Int).Abs(g.I)
+	case g.I.Sign() == 0:
+		abs = iPool.Get().(*big.Int).Abs(g.R)
+	default:
+		return g.Norm().ProbablyPrime(primalityRounds)
+	}
+	defer iPool.Put(abs)
+	return abs.Bit(0) == 1 && abs.Bit(1) == 1 && abs.ProbablyPrime(primalityRounds)
+}
+
+// NextGaussianPrime returns the smallest Gaussian prime greater than the given Gaussian integer
+// in the total order defined by Cmp
+func NextGaussianPrime(after *GaussianInt) *GaussianInt {
+	norm := after.Norm()
+	for {
+		for _, g := range GaussianIntsWithNorm(norm) {
+			if g.Cmp(after) > 0 && g.IsPrime() {
+				return g
+			}
+		}
+		norm.Add(norm, big1)
+	}
+}
+
+// GaussianPrimesUpToNorm returns all the Gaussian primes with norms not larger than the bound
+// in the total order defined by Cmp
+// If dedupAssociates is true, only the associate in the first quadrant (positive real part
+// and non-negative imaginary part) of each Gaussian prime is returned
+func GaussianPrimesUpToNorm(bound *big.Int, dedupAssociates bool) []*GaussianInt {
+	var primes []*GaussianInt
+	if bound.Sign() <= 0 {
+		return primes
+	}
+	limit := new(big.Int).Sqrt(bound)
+	start := new(big.Int).Neg(limit)
+	if dedupAssociates {
+		start.SetInt64(0)
+	}
+	for r := new(big.Int).Set(start); r.Cmp(limit) <= 0; r.Add(r, big1) {
+		for i := new(big.Int).Set(start); i.Cmp(limit) <= 0; i.Add(i, big1) {
+			if dedupAssociates && r.Sign() == 0 {
+				continue
+			}
+			g := NewGaussianInt(r, i)
+			if g.Norm().Cmp(bound) > 0 {
+				continue
+			}
+			if g.IsPrime() {
+				primes = append(primes, g)
+			}
+		}
+	}
+	SortByNorm(primes)
+	return primes
+}
+
+// GaussianIntsWithNorm returns all the Gaussian integers with the given norm, i.e. the lattice points
+// on the circle of radius sqrt(norm), in the total order defined by Cmp
+// Each two-square representation norm = r^2 + i^2 is found by scanning r from -sqrt(norm) to sqrt(norm),
+// so the number of results is r2(norm), and the result is an empty slice if norm has no such representation
+func GaussianIntsWithNorm(norm *big.Int) []*GaussianInt {
+	res := []*GaussianInt{}
+	if norm.Sign() < 0 {
+		return res
+	}
+	limit := new(big.Int).Sqrt(norm)
+	rest, i := new(big.Int), new(big.Int)
+	for r := new(big.Int).Neg(limit); r.Cmp(limit) <= 0; r.Add(r, big1) {
+		rest.Mul(r, r)
+		rest.Sub(norm, rest)
+		i.Sqrt(rest)
+		if new(big.Int).Mul(i, i).Cmp(rest) != 0 {
+			continue
+		}
+		if i.Sign() == 0 {
+			res = append(res, NewGaussianInt(r, i))
+			continue
+		}
+		res = append(res, NewGaussianInt(r, new(big.Int).Neg(i)), NewGaussianInt(r, i))
+	}
+	return res
+}
+
+// Factorize returns the factorization of the Gaussian integer into Gaussian primes,
+// i.e. g = unit * primes[0]^exps[0] * ... * primes[n-1]^exps[n-1]
+// The primes are the canonical associates given by Normalize, in ascending order of the rational primes below them
+// The norm of g is factorized by trial division, so it is only practical for norms without two or more large prime factors
+// Zero has no factorization, so the results are all nil
+func (g *GaussianInt) Factorize() (primes []*GaussianInt, exps []int, unit *GaussianInt) {
+	if g.IsZero() {
+		return nil, nil, nil
+	}
+	cur := g.Copy()
+	remainder := giPool.Get().(*GaussianInt)
+	defer giPool.Put(remainder)
+	rationalPrimes, _ := trialFactor(g.Norm())
+	for _, p := range rationalPrimes {
+		var candidates []*GaussianInt
+		switch {
+		case p.Cmp(big2) == 0:
+			// 2 ramifies as -i(1+i)^2
+			candidates = []*GaussianInt{NewGaussianInt(big1, big1)}
+		case p.Bit(1) == 1:
+			// primes congruent to 3 modulo 4 stay inert
+			candidates = []*GaussianInt{NewGaussianInt(p, big0)}
+		default:
+			// primes congruent to 1 modulo 4 split as (x+yi)(x-yi) with x^2 + y^2 = p
+			x, y, _ := Cornacchia(big1, p)
+			candidates = []*GaussianInt{
+				new(GaussianInt).Normalize(NewGaussianInt(x, y)),
+				new(GaussianInt).Normalize(NewGaussianInt(x, new(big.Int).Neg(y))),
+			}
+		}
+		for _, c := range candidates {
+			e := 0
+			for {
+				quotient := remainder.Div(cur, c)
+				if !remainder.IsZero() {
+					break
+				}
+				cur = quotient
+				e++
+			}
+			if e > 0 {
+				primes = append(primes, c)
+				exps = append(exps, e)
+			}
+		}
+	}
+	return primes, exps, cur
+}
+
+// FactorizeNormalized returns the Gaussian prime factors of the Gaussian integer repeated by their exponents,
+// each being the canonical associate given by Normalize, sorted by Cmp, e.g. [1+i, 1+i, 3, 3] for 18i,
+// so the result is reproducible and the same for all associates of g
+// The unit of the factorization is dropped, use Factorize to obtain it
+// The units have no prime factors and give an empty slice, and zero has no factorization and gives nil
+func (g *GaussianInt) FactorizeNormalized() []*GaussianInt {
+	if g.IsZero() {
+		return nil
+	}
+	primes, exps, _ := g.Factorize()
+	factors := []*GaussianInt{}
+	for idx, p := range primes {
+		for e := 0; e < exps[idx]; e++ {
+			factors = append(factors, new(GaussianInt).Normalize(p))
+		}
+	}
+	SortByNorm(factors)
+	return factors
+}
+
+// IsPerfectPower detects whether the Gaussian integer equals base^exp for some exponent exp >= 2
+// The largest such exponent is chosen, so that the base has the smallest norm
+// Zero and the units are not considered as perfect powers
+// It relies on Factorize, so it is only practical for norms without two or more large prime factors
+func (g *GaussianInt) IsPerfectPower() (base *GaussianInt, exp int, ok bool) {
+	if g.IsZero() || g.IsUnit() {
+		return nil, 0, false
+	}
+	primes, exps, unit := g.Factorize()
+	d := 0
+	for _, e := range exps {
+		d = gcdInt(d, e)
+	}
+	// the unit is i^k
+	k := 0
+	switch {
+	case unit.I.Sign() > 0:
+		k = 1
+	case unit.R.Sign() < 0:
+		k = 2
+	case unit.I.Sign() < 0:
+		k = 3
+	}
+	for exp = d; exp >= 2; exp-- {
+		if d%exp != 0 {
+			continue
+		}
+		// find the unit i^m with (i^m)^exp = i^k
+		for m := 0; m < 4; m++ {
+			if m*exp%4 != k {
+				continue
+			}
+			base = One()
+			for idx, p := range primes {
+				for e := 0; e < exps[idx]/exp; e++ {
+					base.Prod(base, p)
+				}
+			}
+			for ; m > 0; m-- {
+				base.Prod(base, ImagUnit())
+			}
+			return base, exp, true
+		}
+	}
+	return nil, 0, false
+}
+
+// IsSquarefree returns true if no Gaussian prime divides the Gaussian integer more than once,
+// i.e. all the exponents in its factorization are 1
+// The units have no prime factors, so they are squarefree, while zero is divisible by every square and is not
+// It relies on Factorize, so it is only practical for norms without two or more large prime factors
+// The answer is only given for a complete factorization, i.e. when the cofactor left by Factorize is a unit,
+// otherwise the Gaussian integer is reported as not squarefree
+func (g *GaussianInt) IsSquarefree() bool {
+	if g.IsZero() {
+		return false
+	}
+	_, exps, cofactor := g.Factorize()
+	if !cofactor.IsUnit() {
+		return false
+	}
+	for _, e := range exps {
+		if e > 1 {
+			return false
+		}
+	}
+	return true
+}
+
+// gcdInt returns the greatest common divisor of two non-negative integers
+func gcdInt(a, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+// IsNormSmooth returns true if every rational prime dividing the norm of the Gaussian integer
+// is not larger than the bound
+// The norm of zero is divisible by every prime, so zero is not smooth for any bound
+func (g *GaussianInt) IsNormSmooth(bound *big.Int) bool {
+	if g.IsZero() {
+		return false
+	}
+	rest := g.Norm()
+	quo, mod := new(big.Int), new(big.Int)
+	opt := iPool.Get().(*big.Int)
+	defer iPool.Put(opt)
+	for p := big.NewInt(2); p.Cmp(bound) <= 0 && opt.Mul(p, p).Cmp(rest) <= 0; p.Add(p, big1) {
+		for {
+			quo.QuoRem(rest, p, mod)
+			if mod.Sign() != 0 {
+				break
+			}
+			rest.Set(quo)
+		}
+	}
+	// the rest is either 1, a prime, or a product of primes larger than the bound
+	return rest.Cmp(bound) <= 0 || rest.Cmp(big1) == 0
+}
+
+// GaussianPrimeFactorOf returns the canonical Gaussian prime pi with norm p for the rational prime p,
+// so that p = pi * conj(pi) up to a unit
+// For p = 1 (mod 4), pi is gcd(p, x+i) where x^2 = -1 (mod p), and for p = 2 it is 1+i
+// ErrInertPrime is returned for p = 3 (mod 4), and ErrNotPrime if p is not a prime
+func GaussianPrimeFactorOf(p *big.Int) (*GaussianInt, error) {
+	if p.Sign() <= 0 || !p.ProbablyPrime(primalityRounds) {
+		return nil, ErrNotPrime
+	}
+	if p.Cmp(big2) == 0 {
+		return NewGaussianInt(big1, big1), nil
+	}
+	x, ok := SqrtMinusOneModP(p)
+	if !ok {
+		return nil, ErrInertPrime
+	}
+	return new(GaussianInt).GCD(NewGaussianInt(p, big0), NewGaussianInt(x, big1)), nil
+}
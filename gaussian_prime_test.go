@@ -0,0 +1,448 @@
+// MIT License
+//
+// Copyright (c) 2022 Tommy TIAN
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package complex
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func TestGaussianInt_IsPrime(t *testing.T) {
+	tests := []struct {
+		name string
+		g    *GaussianInt
+		want bool
+	}{
+		{
+			name: "test_1+i",
+			g:    NewGaussianInt(big.NewInt(1), big.NewInt(1)),
+			want: true,
+		},
+		{
+			name: "test_2",
+			g:    NewGaussianInt(big.NewInt(2), big.NewInt(0)),
+			want: false,
+		},
+		{
+			name: "test_-3",
+			g:    NewGaussianInt(big.NewInt(-3), big.NewInt(0)),
+			want: true,
+		},
+		{
+			name: "test_5i",
+			g:    NewGaussianInt(big.NewInt(0), big.NewInt(5)),
+			want: false,
+		},
+		{
+			name: "test_7i",
+			g:    NewGaussianInt(big.NewInt(0), big.NewInt(7)),
+			want: true,
+		},
+		{
+			name: "test_2-3i",
+			g:    NewGaussianInt(big.NewInt(2), big.NewInt(-3)),
+			want: true,
+		},
+		{
+			name: "test_1",
+			g:    NewGaussianInt(big.NewInt(1), big.NewInt(0)),
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.g.IsPrime(); got != tt.want {
+				t.Errorf("IsPrime() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNextGaussianPrime(t *testing.T) {
+	tests := []struct {
+		name  string
+		after *GaussianInt
+		want  *GaussianInt
+	}{
+		{
+			name:  "test_0",
+			after: NewGaussianInt(big.NewInt(0), big.NewInt(0)),
+			want:  NewGaussianInt(big.NewInt(-1), big.NewInt(-1)),
+		},
+		{
+			name:  "test_1+i",
+			after: NewGaussianInt(big.NewInt(1), big.NewInt(1)),
+			want:  NewGaussianInt(big.NewInt(-2), big.NewInt(-1)),
+		},
+		{
+			name:  "test_2+i",
+			after: NewGaussianInt(big.NewInt(2), big.NewInt(1)),
+			want:  NewGaussianInt(big.NewInt(-3), big.NewInt(0)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NextGaussianPrime(tt.after); !got.Equals(tt.want) {
+				t.Errorf("NextGaussianPrime() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGaussianPrimesUpToNorm(t *testing.T) {
+	want := []*GaussianInt{
+		NewGaussianInt(big.NewInt(1), big.NewInt(1)),
+		NewGaussianInt(big.NewInt(1), big.NewInt(2)),
+		NewGaussianInt(big.NewInt(2), big.NewInt(1)),
+		NewGaussianInt(big.NewInt(3), big.NewInt(0)),
+	}
+	got := GaussianPrimesUpToNorm(big.NewInt(10), true)
+	if len(got) != len(want) {
+		t.Fatalf("GaussianPrimesUpToNorm(10, true) = %v, want %v", got, want)
+	}
+	for idx := range got {
+		if !got[idx].Equals(want[idx]) {
+			t.Fatalf("GaussianPrimesUpToNorm(10, true) = %v, want %v", got, want)
+		}
+	}
+	if got = GaussianPrimesUpToNorm(big.NewInt(10), false); len(got) != 4*len(want) {
+		t.Errorf("GaussianPrimesUpToNorm(10, false) = %v, want %d primes", got, 4*len(want))
+	}
+}
+
+func TestGaussianIntsWithNorm(t *testing.T) {
+	// r2(n) = 4 * (d1(n) - d3(n)), where dk(n) counts the divisors of n congruent to k modulo 4
+	r2 := func(n int64) int {
+		count := 0
+		for d := int64(1); d <= n; d++ {
+			if n%d != 0 {
+				continue
+			}
+			switch d % 4 {
+			case 1:
+				count += 4
+			case 3:
+				count -= 4
+			}
+		}
+		return count
+	}
+	for n := int64(1); n <= 200; n++ {
+		got := GaussianIntsWithNorm(big.NewInt(n))
+		if len(got) != r2(n) {
+			t.Fatalf("GaussianIntsWithNorm(%d) = %v, want %d elements", n, got, r2(n))
+		}
+		for k, g := range got {
+			if g.Norm().Int64() != n {
+				t.Fatalf("GaussianIntsWithNorm(%d) contains %v with norm %v", n, g, g.Norm())
+			}
+			if k > 0 && got[k-1].Cmp(g) >= 0 {
+				t.Fatalf("GaussianIntsWithNorm(%d) = %v, not in ascending order", n, got)
+			}
+		}
+	}
+	if got := GaussianIntsWithNorm(big.NewInt(3)); got == nil || len(got) != 0 {
+		t.Errorf("GaussianIntsWithNorm(3) = %#v, want empty slice", got)
+	}
+	if got := GaussianIntsWithNorm(big.NewInt(0)); len(got) != 1 || !got[0].IsZero() {
+		t.Errorf("GaussianIntsWithNorm(0) = %v, want [0]", got)
+	}
+	if got := GaussianIntsWithNorm(big.NewInt(-5)); got == nil || len(got) != 0 {
+		t.Errorf("GaussianIntsWithNorm(-5) = %v, want empty slice", got)
+	}
+}
+
+func TestGaussianInt_Factorize(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for n := 0; n < 200; n++ {
+		g := NewGaussianInt(big.NewInt(r.Int63n(2001)-1000), big.NewInt(r.Int63n(2001)-1000))
+		if g.IsZero() {
+			continue
+		}
+		primes, exps, unit := g.Factorize()
+		if !unit.IsUnit() {
+			t.Fatalf("Factorize(%v) unit = %v, not a unit", g, unit)
+		}
+		got := unit.Copy()
+		for idx, p := range primes {
+			if !p.IsPrime() {
+				t.Fatalf("Factorize(%v) contains %v, not a Gaussian prime", g, p)
+			}
+			for e := 0; e < exps[idx]; e++ {
+				got.Prod(got, p)
+			}
+		}
+		if !got.Equals(g) {
+			t.Fatalf("Factorize(%v) = %v, %v, %v, product %v", g, primes, exps, unit, got)
+		}
+	}
+}
+
+func TestGaussianInt_IsPerfectPower(t *testing.T) {
+	tests := []struct {
+		name     string
+		g        *GaussianInt
+		wantBase *GaussianInt
+		wantExp  int
+		wantOK   bool
+	}{
+		{
+			name:     "test_(2+i)^3",
+			g:        NewGaussianInt(big.NewInt(2), big.NewInt(11)),
+			wantBase: NewGaussianInt(big.NewInt(2), big.NewInt(1)),
+			wantExp:  3,
+			wantOK:   true,
+		},
+		{
+			name:     "test_-4",
+			g:        NewGaussianInt(big.NewInt(-4), big.NewInt(0)),
+			wantBase: NewGaussianInt(big.NewInt(1), big.NewInt(1)),
+			wantExp:  4,
+			wantOK:   true,
+		},
+		{
+			name:     "test_-3-4i",
+			g:        NewGaussianInt(big.NewInt(-3), big.NewInt(-4)),
+			wantBase: NewGaussianInt(big.NewInt(-1), big.NewInt(2)),
+			wantExp:  2,
+			wantOK:   true,
+		},
+		{
+			name:   "test_-4+3i",
+			g:      NewGaussianInt(big.NewInt(-4), big.NewInt(3)),
+			wantOK: false,
+		},
+		{
+			name:   "test_6",
+			g:      NewGaussianInt(big.NewInt(6), big.NewInt(0)),
+			wantOK: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			base, exp, ok := tt.g.IsPerfectPower()
+			if ok != tt.wantOK {
+				t.Fatalf("IsPerfectPower() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if !ok {
+				return
+			}
+			if !base.Equals(tt.wantBase) || exp != tt.wantExp {
+				t.Errorf("IsPerfectPower() = %v, %v, want %v, %v", base, exp, tt.wantBase, tt.wantExp)
+			}
+		})
+	}
+}
+
+func TestGaussianInt_IsNormSmooth(t *testing.T) {
+	// no Gaussian integer has norm 2 * 3 * 5, since 3 is inert, so 3+9i with norm 2 * 3^2 * 5 is used
+	g := NewGaussianInt(big.NewInt(3), big.NewInt(9))
+	tests := []struct {
+		name  string
+		g     *GaussianInt
+		bound *big.Int
+		want  bool
+	}{
+		{
+			name:  "test_3+9i_4",
+			g:     g,
+			bound: big.NewInt(4),
+			want:  false,
+		},
+		{
+			name:  "test_3+9i_5",
+			g:     g,
+			bound: big.NewInt(5),
+			want:  true,
+		},
+		{
+			name:  "test_1_1",
+			g:     NewGaussianInt(big.NewInt(0), big.NewInt(1)),
+			bound: big.NewInt(1),
+			want:  true,
+		},
+		{
+			name:  "test_10+i_100",
+			g:     NewGaussianInt(big.NewInt(10), big.NewInt(1)),
+			bound: big.NewInt(100),
+			want:  false,
+		},
+		{
+			name:  "test_0_100",
+			g:     NewGaussianInt(big.NewInt(0), big.NewInt(0)),
+			bound: big.NewInt(100),
+			want:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.g.IsNormSmooth(tt.bound); got != tt.want {
+				t.Errorf("IsNormSmooth() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGaussianPrimeFactorOf(t *testing.T) {
+	tests := []struct {
+		name    string
+		p       *big.Int
+		want    *GaussianInt
+		wantErr error
+	}{
+		{"test_p=2", big.NewInt(2), NewGaussianInt(big.NewInt(1), big.NewInt(1)), nil},
+		{"test_p=5", big.NewInt(5), NewGaussianInt(big.NewInt(2), big.NewInt(1)), nil},
+		{"test_p=13", big.NewInt(13), NewGaussianInt(big.NewInt(2), big.NewInt(3)), nil},
+		{"test_p=3", big.NewInt(3), nil, ErrInertPrime},
+		{"test_p=15", big.NewInt(15), nil, ErrNotPrime},
+		{"test_p=1", big.NewInt(1), nil, ErrNotPrime},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GaussianPrimeFactorOf(tt.p)
+			if err != tt.wantErr {
+				t.Fatalf("GaussianPrimeFactorOf() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && !got.Equals(tt.want) {
+				t.Errorf("GaussianPrimeFactorOf() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	for _, p := range []int64{13, 17, 29, 37, 41, 1000000009, 998244353} {
+		got, err := GaussianPrimeFactorOf(big.NewInt(p))
+		if err != nil || got.Norm().Int64() != p || !got.IsPrime() || !got.Equals(new(GaussianInt).Normalize(got)) {
+			t.Errorf("GaussianPrimeFactorOf(%d) = %v, %v, want a canonical Gaussian prime of norm %d", p, got, err, p)
+		}
+	}
+}
+
+func TestGaussianInt_IsSquarefree(t *testing.T) {
+	tests := []struct {
+		name string
+		g    *GaussianInt
+		want bool
+	}{
+		{"test_zero", NewGaussianInt(big.NewInt(0), big.NewInt(0)), false},
+		{"test_unit", NewGaussianInt(big.NewInt(0), big.NewInt(-1)), true},
+		{"test_(1+i)^2", NewGaussianInt(big.NewInt(0), big.NewInt(2)), false},
+		{"test_(2+i)*3", NewGaussianInt(big.NewInt(6), big.NewInt(3)), true},
+		{"test_5", NewGaussianInt(big.NewInt(5), big.NewInt(0)), true},
+		{"test_9", NewGaussianInt(big.NewInt(9), big.NewInt(0)), false},
+		{"test_(2+i)^2", NewGaussianInt(big.NewInt(3), big.NewInt(4)), false},
+		{"test_(2+i)(2-i)(1+i)", NewGaussianInt(big.NewInt(5), big.NewInt(5)), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.g.IsSquarefree(); got != tt.want {
+				t.Errorf("IsSquarefree() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// compare with the definition: no square d^2 of a non-unit d divides g
+	rem := new(GaussianInt)
+	for r := int64(-30); r <= 30; r++ {
+		for i := int64(-30); i <= 30; i++ {
+			g := NewGaussianInt(big.NewInt(r), big.NewInt(i))
+			if g.IsZero() {
+				continue
+			}
+			if _, _, cofactor := g.Factorize(); !cofactor.IsUnit() {
+				t.Fatalf("Factorize(%v) cofactor = %v, want a unit", g, cofactor)
+			}
+			want := true
+			for dr := int64(0); dr*dr <= 2*30 && want; dr++ {
+				for di := int64(0); dr*dr+di*di <= 2*30; di++ {
+					d := NewGaussianInt(big.NewInt(dr), big.NewInt(di))
+					if d.IsZero() || d.IsUnit() {
+						continue
+					}
+					if rem.Div(g, new(GaussianInt).Prod(d, d)); rem.IsZero() {
+						want = false
+						break
+					}
+				}
+			}
+			if got := g.IsSquarefree(); got != want {
+				t.Fatalf("IsSquarefree(%v) = %v, want %v", g, got, want)
+			}
+		}
+	}
+}
+
+func TestGaussianInt_FactorizeNormalized(t *testing.T) {
+	newG := func(r, i int64) *GaussianInt {
+		return NewGaussianInt(big.NewInt(r), big.NewInt(i))
+	}
+	tests := []struct {
+		name string
+		g    *GaussianInt
+		want []*GaussianInt
+	}{
+		{"test_zero", newG(0, 0), nil},
+		{"test_unit", newG(0, -1), []*GaussianInt{}},
+		{"test_18i", newG(0, 18), []*GaussianInt{newG(1, 1), newG(1, 1), newG(3, 0), newG(3, 0)}},
+		{"test_15", newG(15, 0), []*GaussianInt{newG(1, 2), newG(2, 1), newG(3, 0)}},
+		{"test_-65i", newG(0, -65), []*GaussianInt{newG(1, 2), newG(2, 1), newG(2, 3), newG(3, 2)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.g.FactorizeNormalized()
+			if !equalGaussianInts(got, tt.want) {
+				t.Errorf("FactorizeNormalized() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for n := 0; n < 100; n++ {
+		g := newG(r.Int63n(2001)-1000, r.Int63n(2001)-1000)
+		if g.IsZero() {
+			continue
+		}
+		first := g.FactorizeNormalized()
+		// factoring again and factoring an associate give identical slices
+		if again := g.FactorizeNormalized(); !equalGaussianInts(first, again) {
+			t.Fatalf("FactorizeNormalized(%v) = %v, then %v", g, first, again)
+		}
+		associate := new(GaussianInt).Prod(g, ImagUnit())
+		if got := associate.FactorizeNormalized(); !equalGaussianInts(first, got) {
+			t.Fatalf("FactorizeNormalized(%v) = %v, want %v as for %v", associate, got, first, g)
+		}
+	}
+}
+
+// equalGaussianInts checks if two slices of Gaussian integers are both nil, or are equal element-wise
+func equalGaussianInts(a, b []*GaussianInt) bool {
+	if (a == nil) != (b == nil) || len(a) != len(b) {
+		return false
+	}
+	for idx := range a {
+		if !a[idx].Equals(b[idx]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,172 @@
+// MIT License
+//
+// Copyright (c) 2022 Tommy TIAN
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package complex
+
+import "math/big"
+
+// GaussianRat implements Gaussian rational, an element of the field Q(i) of fractions of the Gaussian integers
+// whose real and imaginary parts are both rational numbers
+type GaussianRat struct {
+	R *big.Rat // real part
+	I *big.Rat // imaginary part
+}
+
+// NewGaussianRat declares a new Gaussian rational with the real and imaginary parts
+func NewGaussianRat(r, i *big.Rat) *GaussianRat {
+	return &GaussianRat{
+		R: new(big.Rat).Set(r),
+		I: new(big.Rat).Set(i),
+	}
+}
+
+// String returns the string representation of the Gaussian rational, e.g. 1/2+3/4i, 1/2-i, or 0,
+// in the same form as GaussianInt.String with big.Rat.RatString coefficients
+func (q *GaussianRat) String() string {
+	rSign, iSign := q.R.Sign(), q.I.Sign()
+	var b []byte
+	if rSign != 0 {
+		b = append(b, q.R.RatString()...)
+	}
+	if iSign == 0 {
+		if rSign == 0 {
+			return "0"
+		}
+		return string(b)
+	}
+	if iSign > 0 && rSign != 0 {
+		b = append(b, '+')
+	}
+	switch {
+	case q.I.IsInt() && q.I.Num().Cmp(bigNeg1) == 0:
+		b = append(b, '-')
+	case q.I.IsInt() && q.I.Num().Cmp(big1) == 0:
+	default:
+		b = append(b, q.I.RatString()...)
+	}
+	return string(append(b, 'i'))
+}
+
+// Set sets the Gaussian rational to the given Gaussian rational
+func (q *GaussianRat) Set(a *GaussianRat) *GaussianRat {
+	return q.Update(a.R, a.I)
+}
+
+// Update updates the Gaussian rational with the given real and imaginary parts
+func (q *GaussianRat) Update(r, i *big.Rat) *GaussianRat {
+	if q.R == nil {
+		q.R = new(big.Rat)
+	}
+	q.R.Set(r)
+	if q.I == nil {
+		q.I = new(big.Rat)
+	}
+	q.I.Set(i)
+	return q
+}
+
+// SetGaussianInt sets the Gaussian rational to the given Gaussian integer
+func (q *GaussianRat) SetGaussianInt(g *GaussianInt) *GaussianRat {
+	if q.R == nil {
+		q.R = new(big.Rat)
+	}
+	q.R.SetInt(g.R)
+	if q.I == nil {
+		q.I = new(big.Rat)
+	}
+	q.I.SetInt(g.I)
+	return q
+}
+
+// GaussianInt returns the Gaussian rational as a new Gaussian integer if both parts are integers,
+// otherwise ok is false and the result is nil
+func (q *GaussianRat) GaussianInt() (g *GaussianInt, ok bool) {
+	if !q.R.IsInt() || !q.I.IsInt() {
+		return nil, false
+	}
+	return NewGaussianInt(q.R.Num(), q.I.Num()), true
+}
+
+// Add adds two Gaussian rationals
+// the result is stored in the Gaussian rational that calls the method and returned
+func (q *GaussianRat) Add(a, b *GaussianRat) *GaussianRat {
+	r := new(big.Rat).Add(a.R, b.R)
+	i := new(big.Rat).Add(a.I, b.I)
+	q.R, q.I = r, i
+	return q
+}
+
+// Sub subtracts two Gaussian rationals
+// the result is stored in the Gaussian rational that calls the method and returned
+func (q *GaussianRat) Sub(a, b *GaussianRat) *GaussianRat {
+	r := new(big.Rat).Sub(a.R, b.R)
+	i := new(big.Rat).Sub(a.I, b.I)
+	q.R, q.I = r, i
+	return q
+}
+
+// Mul multiplies two Gaussian rationals
+// the result is stored in the Gaussian rational that calls the method and returned
+func (q *GaussianRat) Mul(a, b *GaussianRat) *GaussianRat {
+	opt := new(big.Rat)
+	r := new(big.Rat).Mul(a.R, b.R)
+	r.Sub(r, opt.Mul(a.I, b.I))
+	i := new(big.Rat).Mul(a.R, b.I)
+	i.Add(i, opt.Mul(a.I, b.R))
+	q.R, q.I = r, i
+	return q
+}
+
+// Inv sets the Gaussian rational to the multiplicative inverse of a, i.e. conj(a) / N(a)
+// the result is stored in the Gaussian rational that calls the method and returned
+// a must not be zero, otherwise Inv panics with a division by zero like big.Rat.Quo
+func (q *GaussianRat) Inv(a *GaussianRat) *GaussianRat {
+	norm := a.Norm()
+	r := new(big.Rat).Quo(a.R, norm)
+	i := new(big.Rat).Quo(a.I, norm)
+	q.R, q.I = r, i.Neg(i)
+	return q
+}
+
+// Div divides two Gaussian rationals exactly, i.e. a / b = a * conj(b) / N(b)
+// the result is stored in the Gaussian rational that calls the method and returned
+// b must not be zero, otherwise Div panics like big.Rat.Quo
+func (q *GaussianRat) Div(a, b *GaussianRat) *GaussianRat {
+	inv := new(GaussianRat).Inv(b)
+	return q.Mul(a, inv)
+}
+
+// Norm returns the norm of the Gaussian rational, i.e. the square of its absolute value
+func (q *GaussianRat) Norm() *big.Rat {
+	norm := new(big.Rat).Mul(q.R, q.R)
+	return norm.Add(norm, new(big.Rat).Mul(q.I, q.I))
+}
+
+// Equals checks if two Gaussian rationals are equal
+func (q *GaussianRat) Equals(a *GaussianRat) bool {
+	return q.R.Cmp(a.R) == 0 && q.I.Cmp(a.I) == 0
+}
+
+// IsZero returns true if the Gaussian rational is equal to zero
+func (q *GaussianRat) IsZero() bool {
+	return q.R.Sign() == 0 && q.I.Sign() == 0
+}
@@ -0,0 +1,102 @@
+// MIT License
+//
+// Copyright (c) 2022 Tommy TIAN
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package complex
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func newGaussianRat(rNum, rDen, iNum, iDen int64) *GaussianRat {
+	return NewGaussianRat(big.NewRat(rNum, rDen), big.NewRat(iNum, iDen))
+}
+
+func TestGaussianRat_String(t *testing.T) {
+	tests := []struct {
+		q    *GaussianRat
+		want string
+	}{
+		{newGaussianRat(0, 1, 0, 1), "0"},
+		{newGaussianRat(1, 2, 1, 2), "1/2+1/2i"},
+		{newGaussianRat(-3, 4, 0, 1), "-3/4"},
+		{newGaussianRat(0, 1, -1, 1), "-i"},
+		{newGaussianRat(2, 1, 1, 1), "2+i"},
+		{newGaussianRat(1, 3, -5, 6), "1/3-5/6i"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.q.String(); got != tt.want {
+				t.Errorf("String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGaussianRat_Div(t *testing.T) {
+	a := new(GaussianRat).SetGaussianInt(NewGaussianInt(big.NewInt(1), big.NewInt(1)))
+	b := new(GaussianRat).SetGaussianInt(NewGaussianInt(big.NewInt(2), big.NewInt(0)))
+	got := new(GaussianRat).Div(a, b)
+	if want := newGaussianRat(1, 2, 1, 2); !got.Equals(want) {
+		t.Errorf("Div() = %v, want %v", got, want)
+	}
+	if _, ok := got.GaussianInt(); ok {
+		t.Errorf("GaussianInt() of %v is ok", got)
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for n := 0; n < 1000; n++ {
+		x := newGaussianRat(r.Int63n(2001)-1000, r.Int63n(100)+1, r.Int63n(2001)-1000, r.Int63n(100)+1)
+		y := newGaussianRat(r.Int63n(2001)-1000, r.Int63n(100)+1, r.Int63n(2001)-1000, r.Int63n(100)+1)
+		if y.IsZero() {
+			continue
+		}
+		quo := new(GaussianRat).Div(x, y)
+		if got := new(GaussianRat).Mul(quo, y); !got.Equals(x) {
+			t.Fatalf("Div(%v, %v) * %v = %v, want %v", x, y, y, got, x)
+		}
+		if got := new(GaussianRat).Mul(y, new(GaussianRat).Inv(y)); !got.Equals(newGaussianRat(1, 1, 0, 1)) {
+			t.Fatalf("%v * Inv() = %v, want 1", y, got)
+		}
+		if got := new(GaussianRat).Sub(new(GaussianRat).Add(x, y), y); !got.Equals(x) {
+			t.Fatalf("%v + %v - %v = %v, want %v", x, y, y, got, x)
+		}
+	}
+}
+
+func TestGaussianRat_GaussianInt(t *testing.T) {
+	g := NewGaussianInt(big.NewInt(-7), big.NewInt(12))
+	q := new(GaussianRat).SetGaussianInt(g)
+	got, ok := q.GaussianInt()
+	if !ok || !got.Equals(g) {
+		t.Errorf("GaussianInt() = %v, %v, want %v, true", got, ok, g)
+	}
+	// (11+7i)/(3+5i) = 2-i is exact
+	quo := new(GaussianRat).Div(
+		new(GaussianRat).SetGaussianInt(NewGaussianInt(big.NewInt(11), big.NewInt(7))),
+		new(GaussianRat).SetGaussianInt(NewGaussianInt(big.NewInt(3), big.NewInt(5))),
+	)
+	if got, ok = quo.GaussianInt(); !ok || !got.Equals(NewGaussianInt(big.NewInt(2), big.NewInt(-1))) {
+		t.Errorf("GaussianInt() = %v, %v, want 2-i, true", got, ok)
+	}
+}
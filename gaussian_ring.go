@@ -0,0 +1,181 @@
+// MIT License
+//
+// Copyright (c) 2022 Tommy TIAN
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package complex
+
+import (
+	"errors"
+	"math/big"
+)
+
+var (
+	// ErrZeroModulus is returned when a modulus of zero is given
+	ErrZeroModulus = errors.New("modulus is zero")
+	// ErrNotCoprime is returned when a Gaussian integer is not coprime to the modulus
+	ErrNotCoprime = errors.New("not coprime to the modulus")
+	// ErrNegativeExponent is returned when a non-negative exponent is expected
+	ErrNegativeExponent = errors.New("negative exponent")
+)
+
+// GaussianRing implements the quotient ring Z[i]/(Mod) of Gaussian integers modulo Mod
+// The results of all the ring operations are reduced modulo Mod
+type GaussianRing struct {
+	Mod *GaussianInt
+}
+
+// NewGaussianRing declares a new quotient ring of Gaussian integers modulo the given modulus
+func NewGaussianRing(mod *GaussianInt) (*GaussianRing, error) {
+	if mod.IsZero() {
+		return nil, ErrZeroModulus
+	}
+	return &GaussianRing{
+		Mod: mod.Copy(),
+	}, nil
+}
+
+// Reduce returns a new Gaussian integer equal to a reduced modulo the ring modulus
+func (r *GaussianRing) Reduce(a *GaussianInt) *GaussianInt {
+	res := new(GaussianInt)
+	res.Div(a, r.Mod)
+	return res
+}
+
+// Add returns a new Gaussian integer equal to (a + b) mod Mod
+func (r *GaussianRing) Add(a, b *GaussianInt) *GaussianInt {
+	sum := giPool.Get().(*GaussianInt).Add(a, b)
+	defer giPool.Put(sum)
+	return r.Reduce(sum)
+}
+
+// Mul returns a new Gaussian integer equal to (a * b) mod Mod
+func (r *GaussianRing) Mul(a, b *GaussianInt) *GaussianInt {
+	prod := giPool.Get().(*GaussianInt).Prod(a, b)
+	defer giPool.Put(prod)
+	return r.Reduce(prod)
+}
+
+// Pow returns a new Gaussian integer equal to (a ^ e) mod Mod using square-and-multiply
+// the exponent e should be non-negative
+func (r *GaussianRing) Pow(a *GaussianInt, e *big.Int) *GaussianInt {
+	res := r.Reduce(One())
+	base := r.Reduce(a)
+	for idx := e.BitLen() - 1; idx >= 0; idx-- {
+		res = r.Mul(res, res)
+		if e.Bit(idx) == 1 {
+			res = r.Mul(res, base)
+		}
+	}
+	return res
+}
+
+// Congruent returns true if a and b are congruent modulo Mod
+func (r *GaussianRing) Congruent(a, b *GaussianInt) bool {
+	diff := giPool.Get().(*GaussianInt).Sub(a, b)
+	defer giPool.Put(diff)
+	return r.Reduce(diff).IsZero()
+}
+
+// MultiplicativeOrder returns the smallest positive integer k such that g^k = 1 modulo the given Gaussian prime
+// The modulus must be a Gaussian prime, otherwise the result is meaningless
+// The order divides N(mod) - 1, the order of the multiplicative group of Z[i]/(mod),
+// which is factorized by trial division, so it is only practical for moduli where N(mod) - 1 has no
+// two or more large prime factors and is out of reach for cryptographic-size moduli
+// If g and mod are not coprime, ErrNotCoprime is returned, which is always the case for g = 0
+func (g *GaussianInt) MultiplicativeOrder(mod *GaussianInt) (*big.Int, error) {
+	ring, err := NewGaussianRing(mod)
+	if err != nil {
+		return nil, err
+	}
+	if g.IsZero() || !new(GaussianInt).GCD(g, mod).IsOne() {
+		return nil, ErrNotCoprime
+	}
+	order := new(big.Int).Sub(mod.Norm(), big1)
+	if order.Sign() == 0 {
+		// every Gaussian integer is congruent to 1 modulo a unit
+		return order.SetInt64(1), nil
+	}
+	primes, _ := trialFactor(order)
+	quo, rem := new(big.Int), new(big.Int)
+	one := One()
+	for _, p := range primes {
+		for {
+			quo.QuoRem(order, p, rem)
+			if rem.Sign() != 0 || !ring.Congruent(ring.Pow(g, quo), one) {
+				break
+			}
+			order.Set(quo)
+		}
+	}
+	return order, nil
+}
+
+// PowModInt sets the Gaussian integer to base ^ exp in (Z/mod)[i], i.e. modulo the rational integer mod,
+// using square-and-multiply and reducing both parts into the range [0, |mod|) after each multiplication
+// ErrZeroModulus is returned if mod is zero, and ErrNegativeExponent if exp is negative,
+// leaving the Gaussian integer unchanged
+// the result is stored in the Gaussian integer that calls the method and returned
+func (g *GaussianInt) PowModInt(base *GaussianInt, exp, mod *big.Int) (*GaussianInt, error) {
+	if mod.Sign() == 0 {
+		return nil, ErrZeroModulus
+	}
+	if exp.Sign() < 0 {
+		return nil, ErrNegativeExponent
+	}
+	b := giPool.Get().(*GaussianInt).Set(base)
+	defer giPool.Put(b)
+	b.R.Mod(b.R, mod)
+	b.I.Mod(b.I, mod)
+	res := giPool.Get().(*GaussianInt).Update(big1, big0)
+	defer giPool.Put(res)
+	res.R.Mod(res.R, mod)
+	for idx := exp.BitLen() - 1; idx >= 0; idx-- {
+		res.Prod(res, res)
+		res.R.Mod(res.R, mod)
+		res.I.Mod(res.I, mod)
+		if exp.Bit(idx) == 1 {
+			res.Prod(res, b)
+			res.R.Mod(res.R, mod)
+			res.I.Mod(res.I, mod)
+		}
+	}
+	return g.Set(res), nil
+}
+
+// ModReduce reduces the Gaussian integer in place to the canonical representative of its residue class modulo mod,
+// i.e. the unique element of the class in the half-open square {s * mod + t * i * mod | 0 <= s, t < 1},
+// the fundamental domain of the lattice generated by mod and i * mod
+// It subtracts q * mod with q = floor(Re(g * conj(mod)) / N(mod)) + floor(Im(g * conj(mod)) / N(mod)) i,
+// so congruent Gaussian integers always reduce to the same representative, whose norm is less than 2 * N(mod)
+// The square depends on mod itself, so associates of mod give different representatives;
+// use the canonical associate given by Normalize to make them agree
+// mod must not be zero, and the reduced Gaussian integer is returned
+func (g *GaussianInt) ModReduce(mod *GaussianInt) *GaussianInt {
+	modConj := giPool.Get().(*GaussianInt).Conj(mod)
+	defer giPool.Put(modConj)
+	coords := giPool.Get().(*GaussianInt).Prod(g, modConj)
+	defer giPool.Put(coords)
+	norm := mod.Norm()
+	// big.Int.Div rounds toward negative infinity for a positive divisor, which is the floor
+	coords.R.Div(coords.R, norm)
+	coords.I.Div(coords.I, norm)
+	return g.Sub(g, coords.Prod(coords, mod))
+}
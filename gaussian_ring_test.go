@@ -0,0 +1,247 @@
+// MIT License
+//
+// Copyright (c) 2022 Tommy TIAN
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package complex
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func TestNewGaussianRing(t *testing.T) {
+	if _, err := NewGaussianRing(NewGaussianInt(big.NewInt(0), big.NewInt(0))); err != ErrZeroModulus {
+		t.Errorf("NewGaussianRing() error = %v, want %v", err, ErrZeroModulus)
+	}
+}
+
+func TestGaussianRing_Mul(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for n := 0; n < 1000; n++ {
+		mod := NewGaussianInt(big.NewInt(r.Int63n(201)-100), big.NewInt(r.Int63n(201)-100))
+		if mod.IsZero() {
+			continue
+		}
+		ring, err := NewGaussianRing(mod)
+		if err != nil {
+			t.Fatalf("NewGaussianRing() error = %v", err)
+		}
+		a := NewGaussianInt(big.NewInt(r.Int63n(20001)-10000), big.NewInt(r.Int63n(20001)-10000))
+		b := NewGaussianInt(big.NewInt(r.Int63n(20001)-10000), big.NewInt(r.Int63n(20001)-10000))
+		got := ring.Mul(a, b)
+		if got.CmpNorm(mod) >= 0 {
+			t.Fatalf("Mul(%v, %v) = %v, not reduced modulo %v", a, b, got, mod)
+		}
+		// the difference between the result and the product must be a multiple of the modulus
+		diff := new(GaussianInt).Prod(a, b)
+		diff.Sub(diff, got)
+		remainder := new(GaussianInt)
+		remainder.Div(diff, mod)
+		if !remainder.IsZero() {
+			t.Fatalf("Mul(%v, %v) = %v, not congruent modulo %v", a, b, got, mod)
+		}
+	}
+}
+
+func TestGaussianRing_Pow(t *testing.T) {
+	// Z[i]/(2+i) is the finite field of order 5, so a^4 = 1 for every nonzero a
+	ring, err := NewGaussianRing(NewGaussianInt(big.NewInt(2), big.NewInt(1)))
+	if err != nil {
+		t.Fatalf("NewGaussianRing() error = %v", err)
+	}
+	for r := int64(1); r < 5; r++ {
+		a := NewGaussianInt(big.NewInt(r), big.NewInt(0))
+		got := ring.Pow(a, big.NewInt(4))
+		if diff := new(GaussianInt).Sub(got, One()); !ring.Reduce(diff).IsZero() {
+			t.Errorf("Pow(%v, 4) = %v, want 1 modulo %v", a, got, ring.Mod)
+		}
+	}
+}
+
+func TestGaussianInt_MultiplicativeOrder(t *testing.T) {
+	tests := []struct {
+		name    string
+		g       *GaussianInt
+		mod     *GaussianInt
+		want    *big.Int
+		wantErr error
+	}{
+		{
+			name: "test_2_mod_2+i",
+			g:    NewGaussianInt(big.NewInt(2), big.NewInt(0)),
+			mod:  NewGaussianInt(big.NewInt(2), big.NewInt(1)),
+			want: big.NewInt(4),
+		},
+		{
+			name: "test_-1_mod_2+i",
+			g:    NewGaussianInt(big.NewInt(-1), big.NewInt(0)),
+			mod:  NewGaussianInt(big.NewInt(2), big.NewInt(1)),
+			want: big.NewInt(2),
+		},
+		{
+			name: "test_6_mod_2+i",
+			g:    NewGaussianInt(big.NewInt(6), big.NewInt(0)),
+			mod:  NewGaussianInt(big.NewInt(2), big.NewInt(1)),
+			want: big.NewInt(1),
+		},
+		{
+			name: "test_1+i_mod_3",
+			g:    NewGaussianInt(big.NewInt(1), big.NewInt(1)),
+			mod:  NewGaussianInt(big.NewInt(3), big.NewInt(0)),
+			want: big.NewInt(8),
+		},
+		{
+			name:    "test_5_mod_2+i",
+			g:       NewGaussianInt(big.NewInt(5), big.NewInt(0)),
+			mod:     NewGaussianInt(big.NewInt(2), big.NewInt(1)),
+			wantErr: ErrNotCoprime,
+		},
+		{
+			name:    "test_0_mod_3",
+			g:       NewGaussianInt(big.NewInt(0), big.NewInt(0)),
+			mod:     NewGaussianInt(big.NewInt(3), big.NewInt(0)),
+			wantErr: ErrNotCoprime,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.g.MultiplicativeOrder(tt.mod)
+			if err != tt.wantErr {
+				t.Fatalf("MultiplicativeOrder() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got.Cmp(tt.want) != 0 {
+				t.Errorf("MultiplicativeOrder() = %v, want %v", got, tt.want)
+			}
+			if new(big.Int).Mod(new(big.Int).Sub(tt.mod.Norm(), big.NewInt(1)), got).Sign() != 0 {
+				t.Errorf("MultiplicativeOrder() = %v, does not divide N(mod) - 1", got)
+			}
+		})
+	}
+}
+
+func TestGaussianInt_PowModInt(t *testing.T) {
+	tests := []struct {
+		name    string
+		base    *GaussianInt
+		exp     int64
+		mod     int64
+		want    *GaussianInt
+		wantErr error
+	}{
+		{"test_(1+i)^4_mod_5", NewGaussianInt(big.NewInt(1), big.NewInt(1)), 4, 5, NewGaussianInt(big.NewInt(1), big.NewInt(0)), nil},
+		{"test_(3-2i)^3_mod_10", NewGaussianInt(big.NewInt(3), big.NewInt(-2)), 3, 10, NewGaussianInt(big.NewInt(1), big.NewInt(4)), nil},
+		{"test_(2+3i)^0_mod_7", NewGaussianInt(big.NewInt(2), big.NewInt(3)), 0, 7, NewGaussianInt(big.NewInt(1), big.NewInt(0)), nil},
+		{"test_(2+3i)^0_mod_1", NewGaussianInt(big.NewInt(2), big.NewInt(3)), 0, 1, NewGaussianInt(big.NewInt(0), big.NewInt(0)), nil},
+		{"test_(-1-i)^5_mod_-3", NewGaussianInt(big.NewInt(-1), big.NewInt(-1)), 5, -3, NewGaussianInt(big.NewInt(1), big.NewInt(1)), nil},
+		{"test_(1+i)^2_mod_0", NewGaussianInt(big.NewInt(1), big.NewInt(1)), 2, 0, nil, ErrZeroModulus},
+		{"test_(1+i)^-1_mod_5", NewGaussianInt(big.NewInt(1), big.NewInt(1)), -1, 5, nil, ErrNegativeExponent},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewGaussianInt(big.NewInt(7), big.NewInt(8))
+			got, err := g.PowModInt(tt.base, big.NewInt(tt.exp), big.NewInt(tt.mod))
+			if err != tt.wantErr {
+				t.Fatalf("PowModInt() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				if got != nil || !g.Equals(NewGaussianInt(big.NewInt(7), big.NewInt(8))) {
+					t.Errorf("PowModInt() = %v, receiver %v, want nil and the receiver unchanged", got, g)
+				}
+				return
+			}
+			if !got.Equals(tt.want) || !g.Equals(tt.want) {
+				t.Errorf("PowModInt() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for n := 0; n < 200; n++ {
+		base := NewGaussianInt(big.NewInt(r.Int63n(2001)-1000), big.NewInt(r.Int63n(2001)-1000))
+		exp := r.Int63n(20)
+		mod := big.NewInt(r.Int63n(1000) + 1)
+		want := One()
+		for k := int64(0); k < exp; k++ {
+			want.Prod(want, base)
+		}
+		want.R.Mod(want.R, mod)
+		want.I.Mod(want.I, mod)
+		if got, err := base.Copy().PowModInt(base, big.NewInt(exp), mod); err != nil || !got.Equals(want) {
+			t.Fatalf("PowModInt(%v, %v, %v) = %v, %v, want %v", base, exp, mod, got, err, want)
+		}
+	}
+}
+
+func TestGaussianInt_ModReduce(t *testing.T) {
+	tests := []struct {
+		name string
+		g    *GaussianInt
+		mod  *GaussianInt
+		want *GaussianInt
+	}{
+		{"test_(7+3i)_mod_3", NewGaussianInt(big.NewInt(7), big.NewInt(3)), NewGaussianInt(big.NewInt(3), big.NewInt(0)), NewGaussianInt(big.NewInt(1), big.NewInt(0))},
+		{"test_(-1-i)_mod_3", NewGaussianInt(big.NewInt(-1), big.NewInt(-1)), NewGaussianInt(big.NewInt(3), big.NewInt(0)), NewGaussianInt(big.NewInt(2), big.NewInt(2))},
+		{"test_(5)_mod_(2+i)", NewGaussianInt(big.NewInt(5), big.NewInt(0)), NewGaussianInt(big.NewInt(2), big.NewInt(1)), NewGaussianInt(big.NewInt(0), big.NewInt(0))},
+		{"test_(3+4i)_mod_(1+i)", NewGaussianInt(big.NewInt(3), big.NewInt(4)), NewGaussianInt(big.NewInt(1), big.NewInt(1)), NewGaussianInt(big.NewInt(0), big.NewInt(1))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := tt.g.Copy()
+			if got := g.ModReduce(tt.mod); got != g || !got.Equals(tt.want) {
+				t.Errorf("ModReduce() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for n := 0; n < 30; n++ {
+		mod := NewGaussianInt(big.NewInt(r.Int63n(21)-10), big.NewInt(r.Int63n(21)-10))
+		if mod.IsZero() {
+			continue
+		}
+		ring, _ := NewGaussianRing(mod)
+		residues := make(map[string]bool)
+		for x := int64(-15); x <= 15; x++ {
+			for y := int64(-15); y <= 15; y++ {
+				g := NewGaussianInt(big.NewInt(x), big.NewInt(y))
+				// a congruent Gaussian integer far away must reduce to the same residue
+				k := NewGaussianInt(big.NewInt(r.Int63()-r.Int63()), big.NewInt(r.Int63()-r.Int63()))
+				shifted := new(GaussianInt).Add(g, k.Prod(k, mod))
+				got := g.Copy().ModReduce(mod)
+				if other := shifted.ModReduce(mod); !other.Equals(got) {
+					t.Fatalf("ModReduce(%v) = %v, ModReduce of a congruent element = %v", g, got, other)
+				}
+				if !ring.Congruent(got, g) || got.Norm().Cmp(new(big.Int).Lsh(mod.Norm(), 1)) >= 0 {
+					t.Fatalf("ModReduce(%v, %v) = %v, not a small congruent element", g, mod, got)
+				}
+				residues[got.String()] = true
+			}
+		}
+		// a 31x31 box covers every residue class when N(mod) is small enough
+		if mod.Norm().Int64() <= 100 && len(residues) != int(mod.Norm().Int64()) {
+			t.Fatalf("ModReduce() gives %d residues modulo %v, want %v", len(residues), mod, mod.Norm())
+		}
+	}
+}
@@ -0,0 +1,97 @@
+// MIT License
+//
+// Copyright (c) 2022 Tommy TIAN
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package complex
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// GCDManyParallel calculates the greatest common divisor of the Gaussian integers in parallel
+// The slice is split into contiguous chunks, one per worker, each chunk is reduced with GCD in its own
+// goroutine, and the chunk results are then combined in chunk order
+// As soon as any running GCD becomes a unit, all the workers stop and the result is 1
+// The result is the canonical associate given by Normalize, so it does not depend on the number of workers
+// or the order of the Gaussian integers; zeros are skipped, and the GCD of no non-zero Gaussian integers is 0
+// If workers is not positive, runtime.GOMAXPROCS(0) workers are used
+// If the context is done before the computation finishes, the result is nil
+func GCDManyParallel(ctx context.Context, xs []*GaussianInt, workers int) *GaussianInt {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers > len(xs) {
+		workers = len(xs)
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var foundUnit int32
+	results := make([]*GaussianInt, workers)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			chunk := xs[w*len(xs)/workers : (w+1)*len(xs)/workers]
+			gcd := gcdFold(ctx, nil, chunk)
+			if gcd != nil && gcd.IsOne() {
+				atomic.StoreInt32(&foundUnit, 1)
+				cancel()
+			}
+			results[w] = gcd
+		}(w)
+	}
+	wg.Wait()
+	if atomic.LoadInt32(&foundUnit) == 1 {
+		return One()
+	}
+	if ctx.Err() != nil {
+		return nil
+	}
+	// the chunk results are few, so they are combined without checking the context again
+	if res := gcdFold(context.Background(), nil, results); res != nil {
+		return res
+	}
+	return NewGaussianInt(big0, big0)
+}
+
+// gcdFold folds the non-zero Gaussian integers into the running GCD acc with GCD, where nil stands for
+// the GCD of no Gaussian integers, and returns the new running GCD
+// It stops early when the running GCD is 1 or the context is done
+func gcdFold(ctx context.Context, acc *GaussianInt, xs []*GaussianInt) *GaussianInt {
+	for _, x := range xs {
+		if (acc != nil && acc.IsOne()) || ctx.Err() != nil {
+			break
+		}
+		if x == nil || x.IsZero() {
+			continue
+		}
+		if acc == nil {
+			acc = new(GaussianInt).Normalize(x)
+			continue
+		}
+		acc.GCD(acc, x)
+	}
+	return acc
+}
@@ -0,0 +1,88 @@
+// MIT License
+//
+// Copyright (c) 2022 Tommy TIAN
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package complex
+
+import (
+	"context"
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+// TestGCDManyParallel compares the parallel GCD with a sequential fold for several numbers of workers,
+// run it with -race to detect data races between the workers
+func TestGCDManyParallel(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	common := NewGaussianInt(big.NewInt(-5), big.NewInt(12))
+	xs := make([]*GaussianInt, 1000)
+	for idx := range xs {
+		x := NewGaussianInt(big.NewInt(r.Int63n(1<<30)-1<<29), big.NewInt(r.Int63n(1<<30)-1<<29))
+		xs[idx] = x.Prod(x, common)
+	}
+	xs[10] = NewGaussianInt(big.NewInt(0), big.NewInt(0))
+	want := new(GaussianInt).Normalize(xs[0])
+	for _, x := range xs[1:] {
+		if !x.IsZero() {
+			want.GCD(want, x)
+		}
+	}
+	if want.IsOne() {
+		t.Fatalf("sequential GCD is 1, the corpus has no common factor")
+	}
+	for _, workers := range []int{0, 1, 3, 8, 2000} {
+		if got := GCDManyParallel(context.Background(), xs, workers); got == nil || !got.Equals(want) {
+			t.Errorf("GCDManyParallel(%d workers) = %v, want %v", workers, got, want)
+		}
+	}
+	// the result does not depend on the order
+	shuffled := CloneGaussianInts(xs)
+	r.Shuffle(len(shuffled), func(i, j int) { shuffled[i], shuffled[j] = shuffled[j], shuffled[i] })
+	if got := GCDManyParallel(context.Background(), shuffled, 4); got == nil || !got.Equals(want) {
+		t.Errorf("GCDManyParallel() of shuffled = %v, want %v", got, want)
+	}
+	// a coprime element makes the GCD a unit
+	xs[500] = NewGaussianInt(big.NewInt(2), big.NewInt(1))
+	if got := GCDManyParallel(context.Background(), xs, 4); got == nil || !got.IsOne() {
+		t.Errorf("GCDManyParallel() with a coprime element = %v, want 1", got)
+	}
+}
+
+func TestGCDManyParallel_Edge(t *testing.T) {
+	zero := NewGaussianInt(big.NewInt(0), big.NewInt(0))
+	if got := GCDManyParallel(context.Background(), nil, 4); got == nil || !got.IsZero() {
+		t.Errorf("GCDManyParallel(nil) = %v, want 0", got)
+	}
+	if got := GCDManyParallel(context.Background(), []*GaussianInt{zero, zero}, 4); got == nil || !got.IsZero() {
+		t.Errorf("GCDManyParallel(0, 0) = %v, want 0", got)
+	}
+	single := []*GaussianInt{zero, NewGaussianInt(big.NewInt(-3), big.NewInt(-3))}
+	if got := GCDManyParallel(context.Background(), single, 2); got == nil || !got.Equals(NewGaussianInt(big.NewInt(3), big.NewInt(3))) {
+		t.Errorf("GCDManyParallel(0, -3-3i) = %v, want 3+3i", got)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	xs := []*GaussianInt{NewGaussianInt(big.NewInt(4), big.NewInt(0)), NewGaussianInt(big.NewInt(6), big.NewInt(0))}
+	if got := GCDManyParallel(ctx, xs, 2); got != nil {
+		t.Errorf("GCDManyParallel() with a cancelled context = %v, want nil", got)
+	}
+}
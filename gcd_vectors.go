@@ -0,0 +1,108 @@
+// MIT License
+//
+// Copyright (c) 2022 Tommy TIAN
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package complex
+
+import "fmt"
+
+// GCDTestVector is a pair of Gaussian integers and their canonical greatest common divisor,
+// all written in the canonical form of StringCanonical
+type GCDTestVector struct {
+	A    string
+	B    string
+	Want string
+}
+
+// GCDTestVectors are golden test vectors for GCD, which downstream packages can check with CheckGCD
+// to validate the gcds produced by their build of the library
+// Each pair is a = d * x and b = d * y for random d, x, and y with components of 64 to 512 bits,
+// where N(x) and N(y) are coprime, so the gcd is the canonical associate of d given by Normalize
+var GCDTestVectors = []GCDTestVector{
+	{
+		A:    "-287656280729033706880492671217831728147-106437783975886930470215032148986955579i",
+		B:    "-35886615479996546758254743965347699678-29559895120924331733964612169229408032i",
+		Want: "12075807814157153087+11794166160630038913i",
+	},
+	{
+		A:    "-79895399079854661869433019732282762461+244511935232521426874627609082721355469i",
+		B:    "153627656352964984697492970304694666133-94042875111541137150341032123691813664i",
+		Want: "7585759148630068266+9734033251680022881i",
+	},
+	{
+		A:    "-1363758128285676331680777063048396560941873178362862253767-4835164880781555129004914349034291793708774172849830469440i",
+		B:    "-2382390893710372854923251729007061780901451665637588128195-5499379713893144313660246501652820978316947469320692236348i",
+		Want: "60026182339694123881451608124+65328500035744601032121172041i",
+	},
+	{
+		A:    "-48368339244699733228612334689809309447495652619668241317761768351901613251571+24887502719219670577083968640782562018236236099361711436530874421978545574234i",
+		B:    "3272120405202508739240554047196685458680285979714654747882398219046337664370-44155985578899940002283818897296135067342802714560527838884016185112334999308i",
+		Want: "10404752688944642288282008681732199972+234743684297764969357253284867840222933i",
+	},
+	{
+		A:    "-14496772859602294316151446505429914591155989786629961863940674395959216778665-6291428460490334185922609831221310002635801561025653487000781650860924084561i",
+		B:    "22365805321014750963268025569552799568141684933228161406051369497297080677339-2487024633399294333584665597125157955907130857971875164372578650020898141872i",
+		Want: "50503467954773834260689627286082924532+45485197675565962352368738862316579873i",
+	},
+	{
+		A:    "639389370175760561190859304767329441981506231691611395770215508851432104982334572174712133129613-232026596702029394155163028231764063827736550994964330089422667342250509155761754076446512445937i",
+		B:    "-524871770345412968925141006921600778257329930280606439230743572999036701842086147361798580128456-295689255204835405794176356947617324958879389538253598099685117412108418379158701286756007693286i",
+		Want: "131742880208830729884103065944137536441844806425+844700820691012234967814530735067973717592231923i",
+	},
+	{
+		A:    "10930880448468622556807438285163093164748663423521877489858449176766246103300283594291032900853429528167152564737453-1830054194333810962413383625715533703114513505198926340328173020337879573851051428745841207235283656149240080126330i",
+		B:    "12218844272880018421685722364865169786600642049891101684781640866688981322760149555460391145014168483106918377001461-3679015506788011070681924396228249266004379308166648059692678765811978899310556245597122897182725254146823554378114i",
+		Want: "1752910741397932719978765191547944211581298348339069717676+894943992069726444324632004222663932123663167305137466091i",
+	},
+	{
+		A:    "103881520475524003470410104892928103326515747853679440626154427520352839749511246029025791561664698726061177849369989465365404699907828638141767908103754+528045381813883373990336483790904974706725038445977119197728992361812292997682241655575230607113179653381264099509998565321932247639657542315541097223214i",
+		B:    "4711914789740722668836768900394833958455466780838459150966345402475112181568326715232160096578024374881910652572482759906590189205165320129637685193955393+635216056509526712971454521309942930336303669091922360271647315490909518743226528231588208020353423859957633084309246841496738022422763593880068698380950i",
+		Want: "76426178636613913480507424911777707734895302440515618584100320264958238743376+83456080332888576264679988807696896994235660279279360367102249143967459003909i",
+	},
+	{
+		A:    "6316087827194986668116195690000636024518104199676847121204518922263379968347549500812109391666697463086758945095440253324308919396827899790150088678693276+3216213725386111001074165166450198924478744048804204063556589135291754168775035261430970033398231507638669952006241516581857094405548970776317976540293362i",
+		B:    "9183393301340761154456688431970987248532135814756414037660152400652488011110549096004783956245535264403865509910819142772017609390516890060241802774436717+6790535774153940960561541215966041429371120599757299311917106181306950690453313444201354325030107291474874450745809310313189976724053717897296382145197919i",
+		Want: "82043704979068809727844826489613748068694558523900344269013584366555059948453+60121815326873094716884470694550825426864402877552084313136979952516661839681i",
+	},
+	{
+		A:    "-244651273916816343419057391038439608083325282862135194089538439834981270760604162944063592908815035360043163662028499988639864175639300506350445475339420858054166064026427223789606300422659292-434809806488041556054110908460277075001376846917417572065518217806972778777178116431574437566875063947041192767966119950538537028460175363432689114471310959160543125623712856512577555486773224i",
+		B:    "-2011596451220113699829029832982282027257376468941937269408157775029446185145669792123571393627203286424057989989590922556717745797601512019148910339260333964715329337174384888966405338808221478-428021189097994392073403066043858819657497117806978624717441271535044896216316331606643856766194743176159237185998037287708212614378221030804821671869527732350985311415123844963026705321822958i",
+		Want: "718289097306023891232156568164947318282290925388898838407641618496900965574032602656591621107470+535825449777027947325820964335196170053295374963735408755473265400408556974220556931682565473278i",
+	},
+	{
+		A:    "-592424903802087383834390449823242183429926460908347018280791992512836202298929680875507163069957818168955439596108810529920763296083454719250115233814796778136189268278460160989145527181189673246055331062554278840294551871335990706-618739473776386775283965797312618351248189573485034644323865683502672862551323488187054826790727394654396782774411175242100174478087460174112295774776389107628245516235347076265587274898781352635575521919581493849788139031547625422i",
+		B:    "-679526664804735838779877673006717404557394755930284686986696796777795888750096147477993554527014752612770205898531947286936130228726443006540899432730747824421452651620568800579834358808938320287381222659258184748498839567720098934+926666627205165746907423471051093757568599503309552015552937425265089960361593910838396903042576207899272311842033085963681181496147897918254874292736428614185653056879553179823804407306614318772435503991886454887529425550568821394i",
+		Want: "5384219643228951831822433679189930992084666735215835746617634723024027991881360007116113826336501019030725698533414+25340965426847496084618328591148106628688901872744794415773553789371730150675281136501745171446225251974930391537366i",
+	},
+	{
+		A:    "-119079788180840143469398214297131057760054442679536196271961357514829654513802433752603663811466590581041086824208622412949825288454903483567281588317997032325588920654549614484891965808569074774384302059221846145992378584609593961793202528805341596547949880145570954396570794213618875045843042109847906080484-166188453514683149514156670682604595035551595249273604658052181352188150482748962567067469534960481252415569688628349498860102833778127820193288017914222036017832121581936133789696554847559667717981911029066179494401049257054736687112595558694081814709641351212523561001870919818479913502021025885477671493576i",
+		B:    "81082644426703087305953558239491183247883707307039062620085183343511001580261048939114528007441599857254626281776597594728773081607197452714839208159327741482497499252491956905605842256023466201596533885783621161915368314884751904646887689183394095106153452051739521658880939392857566580314943975988158634304-16022271479719245466775884479167682064120446836198144122651181559932989276443004037303023439021296633132325336899919325940078096631011643244185246234342149046000092444741621351244522344599725436353571538500975984442167064362950597323880207350203968766977763387157531337951899411418464399329910052769726630320i",
+		Want: "12512705058508853605790782199936259567520975015021649592301897019885276746007675962853072400638587782866669030987259704142315973361393366746933095683801808+4991818211988745608279369297938358234658813451646105563396585737154541109161392130446450322871078553472111860511883099879089566737051927125493908833402300i",
+	},
+}
+
+// CheckGCD returns an error if the greatest common divisor of a and b computed by GCD is not want
+func CheckGCD(a, b, want *GaussianInt) error {
+	if got := new(GaussianInt).GCD(a, b); !got.Equals(want) {
+		return fmt.Errorf("GCD(%v, %v) = %v, want %v", a, b, got, want)
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+// MIT License
+//
+// Copyright (c) 2022 Tommy TIAN
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package complex
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestGCDTestVectors(t *testing.T) {
+	if len(GCDTestVectors) < 10 {
+		t.Fatalf("len(GCDTestVectors) = %d, want at least 10", len(GCDTestVectors))
+	}
+	for _, v := range GCDTestVectors {
+		a, errA := ParseGaussianIntCanonical(v.A)
+		b, errB := ParseGaussianIntCanonical(v.B)
+		want, errW := ParseGaussianIntCanonical(v.Want)
+		if errA != nil || errB != nil || errW != nil {
+			t.Fatalf("invalid test vector %v", v)
+		}
+		if err := CheckGCD(a, b, want); err != nil {
+			t.Error(err)
+		}
+		if !new(GaussianInt).Normalize(want).Equals(want) {
+			t.Errorf("test vector gcd %v is not canonical", want)
+		}
+		for _, x := range []*GaussianInt{a, b} {
+			remainder := new(GaussianInt)
+			if remainder.Div(x, want); !remainder.IsZero() {
+				t.Errorf("test vector gcd %v does not divide %v", want, x)
+			}
+		}
+	}
+}
+
+func TestCheckGCD(t *testing.T) {
+	a := NewGaussianInt(big.NewInt(6), big.NewInt(3))
+	b := NewGaussianInt(big.NewInt(0), big.NewInt(5))
+	if err := CheckGCD(a, b, NewGaussianInt(big.NewInt(2), big.NewInt(1))); err != nil {
+		t.Errorf("CheckGCD() error = %v, want nil", err)
+	}
+	if err := CheckGCD(a, b, NewGaussianInt(big.NewInt(1), big.NewInt(-2))); err == nil {
+		t.Errorf("CheckGCD() error = nil, want an error for a non-canonical gcd")
+	}
+}
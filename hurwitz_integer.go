@@ -23,9 +23,14 @@
 package complex
 
 import (
+	"errors"
 	"math/big"
+	"math/rand"
 )
 
+// ErrNotUnit is returned when an operation requires a unit Hurwitz integer, i.e. one with norm 1
+var ErrNotUnit = errors.New("not a unit")
+
 // HurwitzInt implements Hurwitz quaternion (or Hurwitz integer) a + bi + cj + dk
 // The set of all Hurwitz quaternion is H = {a + bi + cj + dk | a, b, c, d are all integers or all half-integers}
 // A mixture of integers and half-integers is excluded
@@ -47,64 +52,83 @@ func (h *HurwitzInt) Init() *HurwitzInt {
 	return h
 }
 
+// HurwitzZero returns a new Hurwitz integer equal to zero
+// A zero value HurwitzInt{} is also treated as zero, so Init is not required before use
+func HurwitzZero() *HurwitzInt {
+	return new(HurwitzInt).Init()
+}
+
+// doubled returns the doubled scalars of the Hurwitz integer for read-only use,
+// substituting zero for the nil scalars of a zero value Hurwitz integer
+func (h *HurwitzInt) doubled() (r, i, j, k *big.Int) {
+	r, i, j, k = h.dblR, h.dblI, h.dblJ, h.dblK
+	if r == nil {
+		r = big0
+	}
+	if i == nil {
+		i = big0
+	}
+	if j == nil {
+		j = big0
+	}
+	if k == nil {
+		k = big0
+	}
+	return
+}
+
 // String returns the string representation of the integral quaternion
 func (h *HurwitzInt) String() string {
-	rSign := h.dblR.Sign()
-	iSign := h.dblI.Sign()
-	jSign := h.dblJ.Sign()
-	kSign := h.dblK.Sign()
-	rABS := iPool.Get().(*big.Int).Abs(h.dblR)
-	defer iPool.Put(rABS)
-	iABS := iPool.Get().(*big.Int).Abs(h.dblI)
-	defer iPool.Put(iABS)
-	jABS := iPool.Get().(*big.Int).Abs(h.dblJ)
-	defer iPool.Put(jABS)
-	kABS := iPool.Get().(*big.Int).Abs(h.dblK)
-	defer iPool.Put(kABS)
-	if rSign == 0 && iSign == 0 && jSign == 0 && kSign == 0 {
-		return "0"
-	}
-	res := ""
-	if rABS.Cmp(big2) == 0 {
-		if rSign < 0 {
-			res += "-"
+	return string(h.Append(nil))
+}
+
+// Append appends the string representation of the integral quaternion to the buffer
+// and returns the extended buffer
+// Each non-zero component is written with its own sign, as an integer or a half-integer with the .5 suffix,
+// and the coefficients of i, j, and k equal to 1 in absolute value are omitted, e.g. -1.5-i+0.5j-61728.5k
+func (h *HurwitzInt) Append(b []byte) []byte {
+	dblR, dblI, dblJ, dblK := h.doubled()
+	leading := true
+	for _, part := range []struct {
+		dbl  *big.Int
+		unit string
+	}{
+		{dblR, ""},
+		{dblI, "i"},
+		{dblJ, "j"},
+		{dblK, "k"},
+	} {
+		if part.dbl.Sign() == 0 {
+			continue
 		}
-		res += "1"
-	} else {
-		res += hiComposeString(0, iSign, rABS, "")
+		b = hiAppendScalar(b, part.dbl, part.unit, leading)
+		leading = false
 	}
-	res += hiComposeString(rSign, iSign, iABS, "i")
-	res += hiComposeString(iSign, jSign, jABS, "j")
-	res += hiComposeString(jSign, kSign, kABS, "k")
-	return res
+	if leading {
+		return append(b, '0')
+	}
+	return b
 }
 
-func hiComposeString(lastSign, thisSign int, abs *big.Int, sign string) string {
-	res := ""
-	if lastSign != 0 && thisSign == 1 {
-		res += "+"
+// hiAppendScalar appends the non-zero scalar with the doubled value dbl followed by its unit to the buffer,
+// with a leading '+' for positive scalars unless it is the leading term
+func hiAppendScalar(b []byte, dbl *big.Int, unit string, leading bool) []byte {
+	if dbl.Sign() < 0 {
+		b = append(b, '-')
+	} else if !leading {
+		b = append(b, '+')
 	}
-	if abs.Cmp(big1) == 0 {
-		if thisSign == 1 {
-			res += "0.5" + sign
-		} else {
-			res += "-0.5" + sign
-		}
-	} else if abs.Cmp(big2) == 0 {
-		if thisSign == 1 {
-			res += sign
-		} else {
-			res += "-" + sign
-		}
-	} else if abs.Sign() != 0 {
-		opt := iPool.Get().(*big.Int)
-		res += opt.Rsh(abs, 1).String()
-		if abs.Bit(0) == 1 {
-			res += ".5"
-		}
-		res += sign
+	abs := iPool.Get().(*big.Int).Abs(dbl)
+	defer iPool.Put(abs)
+	if unit != "" && abs.Cmp(big2) == 0 {
+		return append(b, unit...)
 	}
-	return res
+	isHalf := abs.Bit(0) == 1
+	b = abs.Rsh(abs, 1).Append(b, 10)
+	if isHalf {
+		b = append(b, ".5"...)
+	}
+	return append(b, unit...)
 }
 
 // NewHurwitzInt declares a new integral quaternion with the real, i, j, and k parts
@@ -126,24 +150,46 @@ func NewHurwitzInt(r, i, j, k *big.Int, doubled bool) *HurwitzInt {
 	}
 }
 
+// HurwitzOne returns a new Hurwitz integer equal to one
+func HurwitzOne() *HurwitzInt {
+	return NewHurwitzInt(big1, big0, big0, big0, false)
+}
+
+// HurwitzI returns a new Hurwitz integer equal to the quaternion unit i
+func HurwitzI() *HurwitzInt {
+	return NewHurwitzInt(big0, big1, big0, big0, false)
+}
+
+// HurwitzJ returns a new Hurwitz integer equal to the quaternion unit j
+func HurwitzJ() *HurwitzInt {
+	return NewHurwitzInt(big0, big0, big1, big0, false)
+}
+
+// HurwitzK returns a new Hurwitz integer equal to the quaternion unit k
+func HurwitzK() *HurwitzInt {
+	return NewHurwitzInt(big0, big0, big0, big1, false)
+}
+
 // Set sets the Hurwitz integer to the given Hurwitz integer
+// nil scalars of the given Hurwitz integer are read as zero
 func (h *HurwitzInt) Set(a *HurwitzInt) *HurwitzInt {
+	r, i, j, k := a.doubled()
 	if h.dblR == nil {
 		h.dblR = new(big.Int)
 	}
-	h.dblR.Set(a.dblR)
+	h.dblR.Set(r)
 	if h.dblI == nil {
 		h.dblI = new(big.Int)
 	}
-	h.dblI.Set(a.dblI)
+	h.dblI.Set(i)
 	if h.dblJ == nil {
 		h.dblJ = new(big.Int)
 	}
-	h.dblJ.Set(a.dblJ)
+	h.dblJ.Set(j)
 	if h.dblK == nil {
 		h.dblK = new(big.Int)
 	}
-	h.dblK.Set(a.dblK)
+	h.dblK.Set(k)
 	return h
 }
 
@@ -154,27 +200,69 @@ func (h *HurwitzInt) Set(a *HurwitzInt) *HurwitzInt {
 
 // Val reveals value of a Hurwitz integer
 func (h *HurwitzInt) Val() (r, i, j, k *big.Float) {
-	r = new(big.Float).SetInt(h.dblR)
+	dblR, dblI, dblJ, dblK := h.doubled()
+	r = new(big.Float).SetInt(dblR)
 	r.Quo(r, big2f)
-	i = new(big.Float).SetInt(h.dblI)
+	i = new(big.Float).SetInt(dblI)
 	i.Quo(i, big2f)
-	j = new(big.Float).SetInt(h.dblJ)
+	j = new(big.Float).SetInt(dblJ)
 	j.Quo(j, big2f)
-	k = new(big.Float).SetInt(h.dblK)
+	k = new(big.Float).SetInt(dblK)
 	k.Quo(k, big2f)
 	return
 }
 
+// R returns the real part of the Hurwitz integer as an exact rational number
+func (h *HurwitzInt) R() *big.Rat {
+	return hiHalfRat(h.dblR)
+}
+
+// I returns the i part of the Hurwitz integer as an exact rational number
+func (h *HurwitzInt) I() *big.Rat {
+	return hiHalfRat(h.dblI)
+}
+
+// J returns the j part of the Hurwitz integer as an exact rational number
+func (h *HurwitzInt) J() *big.Rat {
+	return hiHalfRat(h.dblJ)
+}
+
+// K returns the k part of the Hurwitz integer as an exact rational number
+func (h *HurwitzInt) K() *big.Rat {
+	return hiHalfRat(h.dblK)
+}
+
+// hiHalfRat returns half of the doubled scalar, treating nil as zero
+func hiHalfRat(dbl *big.Int) *big.Rat {
+	if dbl == nil {
+		return new(big.Rat)
+	}
+	return new(big.Rat).SetFrac(dbl, big2)
+}
+
 // ValInt reveals value of a Hurwitz integer in integer
+// Half-integer components are rounded toward zero by RoundFloat, e.g. 2.5 to 2 and -2.5 to -2
 func (h *HurwitzInt) ValInt() (r, i, j, k *big.Int) {
 	rF, iF, jF, kF := h.Val()
-	r = roundFloat(rF)
-	i = roundFloat(iF)
-	j = roundFloat(jF)
-	k = roundFloat(kF)
+	r = RoundFloat(rF)
+	i = RoundFloat(iF)
+	j = RoundFloat(jF)
+	k = RoundFloat(kF)
 	return
 }
 
+// IntComponents returns the integer components of the Hurwitz integer without rounding through big floats
+// If the Hurwitz integer is a Lipschitz integer, the components are exact and exact is true,
+// otherwise the half-integer components are rounded toward zero like ValInt and exact is false
+func (h *HurwitzInt) IntComponents() (r, i, j, k *big.Int, exact bool) {
+	dblR, dblI, dblJ, dblK := h.doubled()
+	r = new(big.Int).Quo(dblR, big2)
+	i = new(big.Int).Quo(dblI, big2)
+	j = new(big.Int).Quo(dblJ, big2)
+	k = new(big.Int).Quo(dblK, big2)
+	return r, i, j, k, dblR.Bit(0) == 0
+}
+
 // Update updates the integral quaternion with the given real, i, j, and k parts
 func (h *HurwitzInt) Update(r, i, j, k *big.Int, doubled bool) *HurwitzInt {
 	if doubled {
@@ -203,193 +291,382 @@ func (h *HurwitzInt) Update(r, i, j, k *big.Int, doubled bool) *HurwitzInt {
 	return h
 }
 
-// Zero sets the Hurwitz integer to zero
+// Zero sets the Hurwitz integer to zero, reusing the existing big integers like Reset
 func (h *HurwitzInt) Zero() *HurwitzInt {
-	h.dblR = big.NewInt(0)
-	h.dblI = big.NewInt(0)
-	h.dblJ = big.NewInt(0)
-	h.dblK = big.NewInt(0)
+	return h.Reset()
+}
+
+// Reset sets the Hurwitz integer to zero in place, reusing the storage of the existing big integers
+func (h *HurwitzInt) Reset() *HurwitzInt {
+	if h.dblR == nil {
+		h.dblR = new(big.Int)
+	}
+	h.dblR.SetInt64(0)
+	if h.dblI == nil {
+		h.dblI = new(big.Int)
+	}
+	h.dblI.SetInt64(0)
+	if h.dblJ == nil {
+		h.dblJ = new(big.Int)
+	}
+	h.dblJ.SetInt64(0)
+	if h.dblK == nil {
+		h.dblK = new(big.Int)
+	}
+	h.dblK.SetInt64(0)
 	return h
 }
 
 // Add adds two integral quaternions
 func (h *HurwitzInt) Add(a, b *HurwitzInt) *HurwitzInt {
+	aR, aI, aJ, aK := a.doubled()
+	bR, bI, bJ, bK := b.doubled()
 	if h.dblR == nil {
 		h.dblR = new(big.Int)
 	}
-	h.dblR.Add(a.dblR, b.dblR)
+	h.dblR.Add(aR, bR)
 	if h.dblI == nil {
 		h.dblI = new(big.Int)
 	}
-	h.dblI.Add(a.dblI, b.dblI)
+	h.dblI.Add(aI, bI)
 	if h.dblJ == nil {
 		h.dblJ = new(big.Int)
 	}
-	h.dblJ.Add(a.dblJ, b.dblJ)
+	h.dblJ.Add(aJ, bJ)
 	if h.dblK == nil {
 		h.dblK = new(big.Int)
 	}
-	h.dblK.Add(a.dblK, b.dblK)
+	h.dblK.Add(aK, bK)
 	return h
 }
 
 // Sub subtracts two integral quaternions
 func (h *HurwitzInt) Sub(a, b *HurwitzInt) *HurwitzInt {
+	aR, aI, aJ, aK := a.doubled()
+	bR, bI, bJ, bK := b.doubled()
 	if h.dblR == nil {
 		h.dblR = new(big.Int)
 	}
-	h.dblR.Sub(a.dblR, b.dblR)
+	h.dblR.Sub(aR, bR)
 	if h.dblI == nil {
 		h.dblI = new(big.Int)
 	}
-	h.dblI.Sub(a.dblI, b.dblI)
+	h.dblI.Sub(aI, bI)
 	if h.dblJ == nil {
 		h.dblJ = new(big.Int)
 	}
-	h.dblJ.Sub(a.dblJ, b.dblJ)
+	h.dblJ.Sub(aJ, bJ)
 	if h.dblK == nil {
 		h.dblK = new(big.Int)
 	}
-	h.dblK.Sub(a.dblK, b.dblK)
+	h.dblK.Sub(aK, bK)
 	return h
 }
 
 // Conj obtains the conjugate of the original integral quaternion
 func (h *HurwitzInt) Conj(origin *HurwitzInt) *HurwitzInt {
+	r, i, j, k := origin.doubled()
+	if h.dblR == nil {
+		h.dblR = new(big.Int)
+	}
+	h.dblR.Set(r)
+	if h.dblI == nil {
+		h.dblI = new(big.Int)
+	}
+	h.dblI.Neg(i)
+	if h.dblJ == nil {
+		h.dblJ = new(big.Int)
+	}
+	h.dblJ.Neg(j)
+	if h.dblK == nil {
+		h.dblK = new(big.Int)
+	}
+	h.dblK.Neg(k)
+	return h
+}
+
+// ConjInPlace conjugates the integral quaternion in place
+func (h *HurwitzInt) ConjInPlace() *HurwitzInt {
 	if h.dblR == nil {
 		h.dblR = new(big.Int)
 	}
-	h.dblR.Set(origin.dblR)
 	if h.dblI == nil {
 		h.dblI = new(big.Int)
 	}
-	h.dblI.Neg(origin.dblI)
 	if h.dblJ == nil {
 		h.dblJ = new(big.Int)
 	}
-	h.dblJ.Neg(origin.dblJ)
 	if h.dblK == nil {
 		h.dblK = new(big.Int)
 	}
-	h.dblK.Neg(origin.dblK)
+	h.dblI.Neg(h.dblI)
+	h.dblJ.Neg(h.dblJ)
+	h.dblK.Neg(h.dblK)
 	return h
 }
 
 // Norm obtains the norm of the integral quaternion
 func (h *HurwitzInt) Norm() *big.Int {
-	norm := new(big.Int).Mul(h.dblR, h.dblR)
-	opt := iPool.Get().(*big.Int).Mul(h.dblI, h.dblI)
+	return h.normInto(new(big.Int))
+}
+
+// normInto stores the norm of the integral quaternion in norm and returns it
+func (h *HurwitzInt) normInto(norm *big.Int) *big.Int {
+	dblR, dblI, dblJ, dblK := h.doubled()
+	norm.Mul(dblR, dblR)
+	opt := iPool.Get().(*big.Int).Mul(dblI, dblI)
 	defer iPool.Put(opt)
 	norm.Add(norm, opt)
-	opt.Mul(h.dblJ, h.dblJ)
+	opt.Mul(dblJ, dblJ)
 	norm.Add(norm, opt)
-	opt.Mul(h.dblK, h.dblK)
+	opt.Mul(dblK, dblK)
 	norm.Add(norm, opt)
 	norm.Rsh(norm, 2)
 	return norm
 }
 
+// HurwitzNormOfProduct returns the norm of a * b as N(a) * N(b), since the norm is multiplicative,
+// without computing the Hamilton product itself
+func HurwitzNormOfProduct(a, b *HurwitzInt) *big.Int {
+	norm := a.Norm()
+	opt := b.normInto(iPool.Get().(*big.Int))
+	defer iPool.Put(opt)
+	return norm.Mul(norm, opt)
+}
+
+// Trace obtains the reduced trace of the integral quaternion, i.e. twice the real part
+func (h *HurwitzInt) Trace() *big.Int {
+	dblR, _, _, _ := h.doubled()
+	return new(big.Int).Set(dblR)
+}
+
+// Dot obtains the Euclidean inner product of two integral quaternions viewed as 4-vectors,
+// which equals the real part of a * conj(h)
+// The result is returned as a big.Rat rather than a big.Int because the inner product of Hurwitz integers
+// can be a half-integer once either of them has half-integer scalars, e.g. (1+i+j+k)/2 . 1 = 1/2 and
+// (1+i+j+k)/2 . (1+i+j-k)/2 = 1/2, so no integer holds it exactly; its denominator is always 1 or 2
+func (h *HurwitzInt) Dot(a *HurwitzInt) *big.Rat {
+	hR, hI, hJ, hK := h.doubled()
+	aR, aI, aJ, aK := a.doubled()
+	dot := new(big.Int).Mul(hR, aR)
+	opt := iPool.Get().(*big.Int).Mul(hI, aI)
+	defer iPool.Put(opt)
+	dot.Add(dot, opt)
+	opt.Mul(hJ, aJ)
+	dot.Add(dot, opt)
+	opt.Mul(hK, aK)
+	dot.Add(dot, opt)
+	return new(big.Rat).SetFrac(dot, opt.SetInt64(4))
+}
+
+// BitLen returns the bit length of the absolute value of the larger-magnitude doubled scalar of the integral
+// quaternion, which is one more than the bit length of the larger-magnitude scalar when it is an integer
+// The bit length of zero is 0
+func (h *HurwitzInt) BitLen() int {
+	dblR, dblI, dblJ, dblK := h.doubled()
+	bitLen := dblR.BitLen()
+	for _, dbl := range []*big.Int{dblI, dblJ, dblK} {
+		if l := dbl.BitLen(); l > bitLen {
+			bitLen = l
+		}
+	}
+	return bitLen
+}
+
 // Copy copies the integral quaternion
+// nil scalars, e.g. of the zero value HurwitzInt{}, are copied as zero
 func (h *HurwitzInt) Copy() *HurwitzInt {
-	return NewHurwitzInt(h.dblR, h.dblI, h.dblJ, h.dblK, true)
+	r, i, j, k := h.doubled()
+	return NewHurwitzInt(r, i, j, k, true)
+}
+
+// Clone is an alias of Copy
+func (h *HurwitzInt) Clone() *HurwitzInt {
+	return h.Copy()
 }
 
 // Prod returns the Hamilton product of two integral quaternions
 // the product (a1 + b1j + c1k + d1)(a2 + b2j + c2k + d2) is determined by the products of the
 // basis elements and the distributive law
+// The product is computed in pooled temporaries and then stored in the big integers of the Hurwitz integer
+// that calls the method, so a receiver from AcquireHurwitzInt is reused without allocating
 func (h *HurwitzInt) Prod(a, b *HurwitzInt) *HurwitzInt {
-	r, i, j, k := new(big.Int), new(big.Int), new(big.Int), new(big.Int)
+	prod := hiPool.Get().(*HurwitzInt).Reset()
+	defer hiPool.Put(prod)
+	doubledProd(prod.dblR, prod.dblI, prod.dblJ, prod.dblK, a, b)
+	return h.Set(prod)
+}
+
+// doubledProd sets r, i, j and k to the doubled scalars of the Hamilton product a * b
+// r, i, j and k must not share storage with a or b
+func doubledProd(r, i, j, k *big.Int, a, b *HurwitzInt) {
+	aR, aI, aJ, aK := a.doubled()
+	bR, bI, bJ, bK := b.doubled()
 	opt := iPool.Get().(*big.Int)
 	defer iPool.Put(opt)
 	// 1 part
-	r.Mul(a.dblR, b.dblR)
-	r.Sub(r, opt.Mul(a.dblI, b.dblI))
-	r.Sub(r, opt.Mul(a.dblJ, b.dblJ))
-	r.Sub(r, opt.Mul(a.dblK, b.dblK))
+	r.Mul(aR, bR)
+	r.Sub(r, opt.Mul(aI, bI))
+	r.Sub(r, opt.Mul(aJ, bJ))
+	r.Sub(r, opt.Mul(aK, bK))
 	r.Rsh(r, 1)
 
 	// i part
-	i.Mul(a.dblR, b.dblI)
-	i.Add(i, opt.Mul(a.dblI, b.dblR))
-	i.Add(i, opt.Mul(a.dblJ, b.dblK))
-	i.Sub(i, opt.Mul(a.dblK, b.dblJ))
+	i.Mul(aR, bI)
+	i.Add(i, opt.Mul(aI, bR))
+	i.Add(i, opt.Mul(aJ, bK))
+	i.Sub(i, opt.Mul(aK, bJ))
 	i.Rsh(i, 1)
 
 	// j part
-	j.Mul(a.dblR, b.dblJ)
-	j.Sub(j, opt.Mul(a.dblI, b.dblK))
-	j.Add(j, opt.Mul(a.dblJ, b.dblR))
-	j.Add(j, opt.Mul(a.dblK, b.dblI))
+	j.Mul(aR, bJ)
+	j.Sub(j, opt.Mul(aI, bK))
+	j.Add(j, opt.Mul(aJ, bR))
+	j.Add(j, opt.Mul(aK, bI))
 	j.Rsh(j, 1)
 
 	// k part
-	k.Mul(a.dblR, b.dblK)
-	k.Add(k, opt.Mul(a.dblI, b.dblJ))
-	k.Sub(k, opt.Mul(a.dblJ, b.dblI))
-	k.Add(k, opt.Mul(a.dblK, b.dblR))
+	k.Mul(aR, bK)
+	k.Add(k, opt.Mul(aI, bJ))
+	k.Sub(k, opt.Mul(aJ, bI))
+	k.Add(k, opt.Mul(aK, bR))
 	k.Rsh(k, 1)
+}
 
-	h.dblR, h.dblI, h.dblJ, h.dblK = r, i, j, k
+// AddMul sets the integral quaternion to h + a * b, with a multiplied by b on the right,
+// which differs from h + b * a unless a and b commute
+// The product is computed in a pooled temporary and added to the existing big integers of h,
+// so a, b and h may alias each other, e.g. h.AddMul(h, x) sets h to h + h * x
+// the result is stored in the integral quaternion that calls the method and returned
+func (h *HurwitzInt) AddMul(a, b *HurwitzInt) *HurwitzInt {
+	prod := hiPool.Get().(*HurwitzInt).Reset()
+	defer hiPool.Put(prod)
+	doubledProd(prod.dblR, prod.dblI, prod.dblJ, prod.dblK, a, b)
+	return h.Add(h, prod)
+}
 
-	return h
+// Mul returns the Hamilton product of two integral quaternions like Prod, matching the naming of math/big
+func (h *HurwitzInt) Mul(a, b *HurwitzInt) *HurwitzInt {
+	return h.Prod(a, b)
+}
+
+// Commutator returns the commutator of two integral quaternions, i.e. a * b - b * a
+func (h *HurwitzInt) Commutator(a, b *HurwitzInt) *HurwitzInt {
+	ab := hiPool.Get().(*HurwitzInt).Prod(a, b)
+	defer hiPool.Put(ab)
+	ba := hiPool.Get().(*HurwitzInt).Prod(b, a)
+	defer hiPool.Put(ba)
+	return h.Sub(ab, ba)
+}
+
+// Anticommutator returns the anticommutator of two integral quaternions, i.e. a * b + b * a
+func (h *HurwitzInt) Anticommutator(a, b *HurwitzInt) *HurwitzInt {
+	ab := hiPool.Get().(*HurwitzInt).Prod(a, b)
+	defer hiPool.Put(ab)
+	ba := hiPool.Get().(*HurwitzInt).Prod(b, a)
+	defer hiPool.Put(ba)
+	return h.Add(ab, ba)
 }
 
 // Div performs Euclidean division of two Hurwitz integers, i.e. a/b
 // the remainder is stored in the Hurwitz integer that calls the method
 // the quotient is returned as a new Hurwitz integer
+// The quotient is the closest point to a * b^-1 in the Hurwitz lattice (the D4 lattice),
+// found by comparing the closest point with all integer scalars against the closest point
+// with all half-integer scalars, so the norm of the remainder is always smaller than the norm of b
 func (h *HurwitzInt) Div(a, b *HurwitzInt) *HurwitzInt {
-	ac := hiPool.Get().(*HurwitzInt)
+	return h.divide(a, b, false)
+}
+
+// Mod reduces a modulo b on the right, i.e. it stores the remainder r of the division a = q * b + r
+// performed by Div in the Hurwitz integer that calls the method
+func (h *HurwitzInt) Mod(a, b *HurwitzInt) *HurwitzInt {
+	h.divide(a, b, false)
+	return h
+}
+
+// ModLeft reduces a modulo b on the left, i.e. it stores the remainder r of the division a = b * q + r
+// in the Hurwitz integer that calls the method
+// The norm of the remainder is smaller than the norm of b
+func (h *HurwitzInt) ModLeft(a, b *HurwitzInt) *HurwitzInt {
+	h.divide(a, b, true)
+	return h
+}
+
+// divide performs Euclidean division of two Hurwitz integers
+// If left is true, the quotient q is the closest Hurwitz integer to b^-1 * a and a = b * q + r,
+// otherwise q is the closest Hurwitz integer to a * b^-1 and a = q * b + r
+// the remainder r is stored in the Hurwitz integer that calls the method
+// the quotient q is returned as a new Hurwitz integer
+func (h *HurwitzInt) divide(a, b *HurwitzInt, left bool) *HurwitzInt {
+	ac := hiPool.Get().(*HurwitzInt).Set(a)
 	defer hiPool.Put(ac)
-	ac = a.Copy()
-	bc := hiPool.Get().(*HurwitzInt)
+	bc := hiPool.Get().(*HurwitzInt).Set(b)
 	defer hiPool.Put(bc)
-	bc = b.Copy()
 
 	bConj := hiPool.Get().(*HurwitzInt).Conj(bc)
 	defer hiPool.Put(bConj)
-	numerator := hiPool.Get().(*HurwitzInt).Prod(ac, bConj)
+	numerator := hiPool.Get().(*HurwitzInt)
 	defer hiPool.Put(numerator)
-	denominator := hiPool.Get().(*HurwitzInt).Prod(bc, bConj)
-	defer hiPool.Put(denominator)
-	deFloat := fPool.Get().(*big.Float).SetInt(denominator.dblR)
-	defer fPool.Put(deFloat)
-
-	rScalar := fPool.Get().(*big.Float).SetInt(numerator.dblR)
-	defer fPool.Put(rScalar)
-	rScalar.Quo(rScalar, deFloat)
-	iScalar := fPool.Get().(*big.Float).SetInt(numerator.dblI)
-	defer fPool.Put(iScalar)
-	iScalar.Quo(iScalar, deFloat)
-	jScalar := fPool.Get().(*big.Float).SetInt(numerator.dblJ)
-	defer fPool.Put(jScalar)
-	jScalar.Quo(jScalar, deFloat)
-	kScalar := fPool.Get().(*big.Float).SetInt(numerator.dblK)
-	defer fPool.Put(kScalar)
-	kScalar.Quo(kScalar, deFloat)
-
-	rsInt := iPool.Get().(*big.Int)
-	defer iPool.Put(rsInt)
-	rsInt = roundFloat(rScalar)
-	isInt := iPool.Get().(*big.Int)
-	defer iPool.Put(isInt)
-	isInt = roundFloat(iScalar)
-	jsInt := iPool.Get().(*big.Int)
-	defer iPool.Put(jsInt)
-	jsInt = roundFloat(jScalar)
-	ksInt := iPool.Get().(*big.Int)
-	defer iPool.Put(ksInt)
-	ksInt = roundFloat(kScalar)
-
-	quotient := NewHurwitzInt(rsInt, isInt, jsInt, ksInt, false)
-	opt := hiPool.Get().(*HurwitzInt)
-	defer hiPool.Put(opt)
-	h.Sub(ac, opt.Prod(quotient, bc))
-	return quotient
+	if left {
+		numerator.Prod(bConj, ac)
+	} else {
+		numerator.Prod(ac, bConj)
+	}
+	// the scalars of the exact quotient are numerator.dblX / (2 * norm)
+	dblNorm := bc.Norm()
+	dblNorm.Lsh(dblNorm, 1)
+
+	intQuo := hiPool.Get().(*HurwitzInt).Init()
+	defer hiPool.Put(intQuo)
+	halfQuo := hiPool.Get().(*HurwitzInt).Init()
+	defer hiPool.Put(halfQuo)
+	halfNorm := iPool.Get().(*big.Int).Rsh(dblNorm, 1)
+	defer iPool.Put(halfNorm)
+	opt := iPool.Get().(*big.Int)
+	defer iPool.Put(opt)
+	numScalars := [4]*big.Int{numerator.dblR, numerator.dblI, numerator.dblJ, numerator.dblK}
+	intScalars := [4]*big.Int{intQuo.dblR, intQuo.dblI, intQuo.dblJ, intQuo.dblK}
+	halfScalars := [4]*big.Int{halfQuo.dblR, halfQuo.dblI, halfQuo.dblJ, halfQuo.dblK}
+	for idx, num := range numScalars {
+		// the nearest integer to x is floor(x + 1/2)
+		opt.Add(num, halfNorm)
+		intScalars[idx].Div(opt, dblNorm)
+		intScalars[idx].Lsh(intScalars[idx], 1)
+		// the nearest half-integer to x is floor(x) + 1/2
+		halfScalars[idx].Div(num, dblNorm)
+		halfScalars[idx].Lsh(halfScalars[idx], 1)
+		halfScalars[idx].Add(halfScalars[idx], big1)
+	}
+
+	intRem := hiPool.Get().(*HurwitzInt)
+	defer hiPool.Put(intRem)
+	halfRem := hiPool.Get().(*HurwitzInt)
+	defer hiPool.Put(halfRem)
+	if left {
+		intRem.Prod(bc, intQuo)
+		halfRem.Prod(bc, halfQuo)
+	} else {
+		intRem.Prod(intQuo, bc)
+		halfRem.Prod(halfQuo, bc)
+	}
+	intRem.Sub(ac, intRem)
+	halfRem.Sub(ac, halfRem)
+
+	if halfRem.CmpNorm(intRem) < 0 {
+		h.Set(halfRem)
+		return halfQuo.Copy()
+	}
+	h.Set(intRem)
+	return intQuo.Copy()
 }
 
 // GCRD calculates the greatest common right-divisor of two Hurwitz integers using Euclidean algorithm
 // The GCD is unique only up to multiplication by a unit (multiplication on the left in the case
 // of a GCRD, and on the right in the case of a GCLD)
+// The algorithm always terminates: Div rounds to the closest point of the D4 lattice, so the norm
+// of each remainder is at most half the norm of the divisor, and the loop runs at most
+// log2(N(b)) + 1 times
 // the result is stored in the Hurwitz integer that calls the method and returned
 func (h *HurwitzInt) GCRD(a, b *HurwitzInt) *HurwitzInt {
 	ac := hiPool.Get().(*HurwitzInt).Set(a)
@@ -400,6 +677,11 @@ func (h *HurwitzInt) GCRD(a, b *HurwitzInt) *HurwitzInt {
 	if ac.CmpNorm(bc) < 0 {
 		ac, bc = bc, ac
 	}
+	if bc.IsZero() {
+		// every Hurwitz integer right-divides zero
+		h.Set(ac)
+		return new(HurwitzInt).Set(ac)
+	}
 	remainder := hiPool.Get().(*HurwitzInt)
 	defer hiPool.Put(remainder)
 	for {
@@ -413,23 +695,323 @@ func (h *HurwitzInt) GCRD(a, b *HurwitzInt) *HurwitzInt {
 	}
 }
 
+// GCRDWithQuotients calculates the greatest common right-divisor like GCRD and also returns the quotients
+// of the Euclidean algorithm
+// With r_0 and r_1 being a and b ordered by norm, r_0 having the larger one, the division is right-handed:
+// quotients[k-1] is the q_k of r_(k-1) = q_k * r_k + r_(k+1), with the quotient on the left of the divisor,
+// and the last non-zero remainder is the GCRD, so the remainders can be replayed from the quotients and
+// the left Bezout cofactors s and t with s * r_0 + t * r_1 = GCRD can be accumulated from them
+// If the smaller operand is zero, there are no quotients and the GCRD is the other operand
+// the result is stored in the Hurwitz integer that calls the method and a copy is returned
+func (h *HurwitzInt) GCRDWithQuotients(a, b *HurwitzInt) (*HurwitzInt, []*HurwitzInt) {
+	ac := new(HurwitzInt).Set(a)
+	bc := new(HurwitzInt).Set(b)
+	if ac.CmpNorm(bc) < 0 {
+		ac, bc = bc, ac
+	}
+	var quotients []*HurwitzInt
+	for !bc.IsZero() {
+		remainder := new(HurwitzInt)
+		quotients = append(quotients, remainder.Div(ac, bc))
+		ac, bc = bc, remainder
+	}
+	h.Set(ac)
+	return new(HurwitzInt).Set(ac), quotients
+}
+
+// IsCoprime returns true if the greatest common right-divisor of the two Hurwitz integers given by GCRD is a unit,
+// i.e. they have no common right-divisor other than the units
+// Zero is coprime only to the units
+func (h *HurwitzInt) IsCoprime(a *HurwitzInt) bool {
+	gcrd := hiPool.Get().(*HurwitzInt)
+	defer hiPool.Put(gcrd)
+	gcrd.GCRD(h, a)
+	return gcrd.IsUnit()
+}
+
 // Equals checks if the two Hurwitz integers are equal
+// nil scalars, e.g. those of a zero value Hurwitz integer, are treated as zero
 func (h *HurwitzInt) Equals(a *HurwitzInt) bool {
-	return h.dblR.Cmp(a.dblR) == 0 &&
-		h.dblI.Cmp(a.dblI) == 0 &&
-		h.dblJ.Cmp(a.dblJ) == 0 &&
-		h.dblK.Cmp(a.dblK) == 0
+	return intEquals(h.dblR, a.dblR) &&
+		intEquals(h.dblI, a.dblI) &&
+		intEquals(h.dblJ, a.dblJ) &&
+		intEquals(h.dblK, a.dblK)
+}
+
+// EqualHurwitz checks if two Hurwitz integers are equal without panicking on nil
+// Two nil Hurwitz integers are equal, a nil and a non-nil Hurwitz integer are not,
+// and nil scalars are treated as zero
+func EqualHurwitz(a, b *HurwitzInt) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Equals(b)
 }
 
 // IsZero returns true if the Hurwitz integer is zero
 func (h *HurwitzInt) IsZero() bool {
-	return h.dblR.Sign() == 0 &&
-		h.dblI.Sign() == 0 &&
-		h.dblJ.Sign() == 0 &&
-		h.dblK.Sign() == 0
+	dblR, dblI, dblJ, dblK := h.doubled()
+	return dblR.Sign() == 0 &&
+		dblI.Sign() == 0 &&
+		dblJ.Sign() == 0 &&
+		dblK.Sign() == 0
+}
+
+// IsOne returns true if the Hurwitz integer is equal to one
+func (h *HurwitzInt) IsOne() bool {
+	dblR, dblI, dblJ, dblK := h.doubled()
+	return dblR.Cmp(big2) == 0 &&
+		dblI.Sign() == 0 &&
+		dblJ.Sign() == 0 &&
+		dblK.Sign() == 0
+}
+
+// IsReal returns true if the i, j, and k scalars of the Hurwitz integer are zero, i.e. it is a rational integer
+// nil scalars are treated as zero, so zero and the zero value are real
+func (h *HurwitzInt) IsReal() bool {
+	_, dblI, dblJ, dblK := h.doubled()
+	return dblI.Sign() == 0 && dblJ.Sign() == 0 && dblK.Sign() == 0
+}
+
+// IsUnit returns true if the Hurwitz integer is one of the 24 units, i.e. its norm is one
+func (h *HurwitzInt) IsUnit() bool {
+	return h.Norm().Cmp(big1) == 0
 }
 
 // CmpNorm compares the norm of two Hurwitz integers
+// The norms are computed in pooled big integers, so the comparison does not allocate
 func (h *HurwitzInt) CmpNorm(a *HurwitzInt) int {
-	return h.Norm().Cmp(a.Norm())
+	hNorm := iPool.Get().(*big.Int)
+	defer iPool.Put(hNorm)
+	aNorm := iPool.Get().(*big.Int)
+	defer iPool.Put(aNorm)
+	return h.normInto(hNorm).Cmp(a.normInto(aNorm))
+}
+
+// HurwitzSum returns the sum of the given Hurwitz integers
+// the sum of an empty list is zero
+func HurwitzSum(xs ...*HurwitzInt) *HurwitzInt {
+	sum := new(HurwitzInt).Init()
+	for _, x := range xs {
+		sum.Add(sum, x)
+	}
+	return sum
+}
+
+// HurwitzProduct returns the product of the given Hurwitz integers from left to right,
+// i.e. xs[0] * xs[1] * ... * xs[n-1], as the Hamilton product is not commutative
+// the product of an empty list is one
+func HurwitzProduct(xs ...*HurwitzInt) *HurwitzInt {
+	prod := HurwitzOne()
+	for _, x := range xs {
+		prod.Prod(prod, x)
+	}
+	return prod
+}
+
+// IsLipschitz returns true if all the scalars of the Hurwitz integer are integers,
+// i.e. the Hurwitz integer is also a Lipschitz integer
+func (h *HurwitzInt) IsLipschitz() bool {
+	dblR, dblI, dblJ, dblK := h.doubled()
+	return dblR.Bit(0) == 0 &&
+		dblI.Bit(0) == 0 &&
+		dblJ.Bit(0) == 0 &&
+		dblK.Bit(0) == 0
+}
+
+// RoundToLipschitz returns a new Lipschitz integer, i.e. a Hurwitz integer with all-integer scalars,
+// nearest to the Hurwitz integer, leaving the Hurwitz integer unchanged
+// A Lipschitz integer is returned as it is; for a half-integer one, every scalar is equally near to
+// the two integers around it, and the tie is broken by rounding toward zero like IntComponents,
+// which gives the nearest Lipschitz integer with the smallest norm, e.g. (1+i-j-k)/2 rounds to 0
+// The difference between the Hurwitz integer and the result is always 0 or a unit
+func (h *HurwitzInt) RoundToLipschitz() *HurwitzInt {
+	r, i, j, k, _ := h.IntComponents()
+	return NewHurwitzInt(r, i, j, k, false)
+}
+
+// HurwitzUnits returns the 24 units of the Hurwitz integers, i.e. the Hurwitz integers with norm 1:
+// the 8 Lipschitz units +-1, +-i, +-j, +-k, followed by the 16 half-integer units (+-1 +-i +-j +-k) / 2
+func HurwitzUnits() []*HurwitzInt {
+	units := make([]*HurwitzInt, 0, 24)
+	for idx := 0; idx < 4; idx++ {
+		for _, sign := range []int64{1, -1} {
+			scalars := [4]*big.Int{big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0)}
+			scalars[idx].SetInt64(2 * sign)
+			units = append(units, NewHurwitzInt(scalars[0], scalars[1], scalars[2], scalars[3], true))
+		}
+	}
+	for mask := 0; mask < 16; mask++ {
+		scalars := [4]*big.Int{}
+		for idx := range scalars {
+			scalars[idx] = big.NewInt(1)
+			if mask&(1<<idx) != 0 {
+				scalars[idx].SetInt64(-1)
+			}
+		}
+		units = append(units, NewHurwitzInt(scalars[0], scalars[1], scalars[2], scalars[3], true))
+	}
+	return units
+}
+
+// RandHurwitzUnit returns one of the 24 units of the Hurwitz integers uniformly at random
+func RandHurwitzUnit(rnd *rand.Rand) *HurwitzInt {
+	return HurwitzUnits()[rnd.Intn(24)]
+}
+
+// UnitOrder returns the multiplicative order of the Hurwitz integer in the group of the 24 units,
+// i.e. the smallest positive n with h^n = 1, computed by repeated Prod
+// The orders are 1 for 1, 2 for -1, 4 for +-i, +-j, +-k, 6 for the half-integer units with real part 1/2,
+// and 3 for those with real part -1/2
+// If the Hurwitz integer is not a unit, ok is false
+func (h *HurwitzInt) UnitOrder() (order int, ok bool) {
+	if !h.IsUnit() {
+		return 0, false
+	}
+	pow := hiPool.Get().(*HurwitzInt).Set(h)
+	defer hiPool.Put(pow)
+	for order = 1; !pow.IsOne(); order++ {
+		pow.Prod(pow, h)
+	}
+	return order, true
+}
+
+// Associates returns the distinct associates of the Hurwitz integer, i.e. its products with the 24 units
+// The unit group is not commutative, so if left is true the units multiply on the left (u * h),
+// otherwise the units multiply on the right (h * u)
+// A nonzero Hurwitz integer has 24 associates on each side, and zero is its only associate
+func (h *HurwitzInt) Associates(left bool) []*HurwitzInt {
+	associates := make([]*HurwitzInt, 0, 24)
+	for _, u := range HurwitzUnits() {
+		if left {
+			u.Prod(u, h)
+		} else {
+			u.Prod(h, u)
+		}
+		duplicated := false
+		for _, a := range associates {
+			if a.Equals(u) {
+				duplicated = true
+				break
+			}
+		}
+		if !duplicated {
+			associates = append(associates, u)
+		}
+	}
+	return associates
+}
+
+// IsRightAssociate returns true if a = h * u for one of the 24 units u
+func (h *HurwitzInt) IsRightAssociate(a *HurwitzInt) bool {
+	return h.isAssociate(a, false)
+}
+
+// IsLeftAssociate returns true if a = u * h for one of the 24 units u
+func (h *HurwitzInt) IsLeftAssociate(a *HurwitzInt) bool {
+	return h.isAssociate(a, true)
+}
+
+// isAssociate checks whether the unit u solving a = u * h (if left is true) or a = h * u
+// is a Hurwitz integer, where u = a * conj(h) / N(h) or u = conj(h) * a / N(h) respectively
+func (h *HurwitzInt) isAssociate(a *HurwitzInt, left bool) bool {
+	norm := h.Norm()
+	if norm.Cmp(a.Norm()) != 0 {
+		return false
+	}
+	if norm.Sign() == 0 {
+		return true
+	}
+	hConj := hiPool.Get().(*HurwitzInt).Conj(h)
+	defer hiPool.Put(hConj)
+	u := hiPool.Get().(*HurwitzInt)
+	defer hiPool.Put(u)
+	if left {
+		u.Prod(a, hConj)
+	} else {
+		u.Prod(hConj, a)
+	}
+	// u has norm N(h)^2, so u / N(h) has norm 1 and is a unit if it is a Hurwitz integer,
+	// i.e. its doubled scalars are integers of the same parity
+	rem := iPool.Get().(*big.Int)
+	defer iPool.Put(rem)
+	parity := uint(2)
+	for _, dbl := range []*big.Int{u.dblR, u.dblI, u.dblJ, u.dblK} {
+		if dbl.QuoRem(dbl, norm, rem); rem.Sign() != 0 {
+			return false
+		}
+		if parity == 2 {
+			parity = dbl.Bit(0)
+		} else if dbl.Bit(0) != parity {
+			return false
+		}
+	}
+	return true
+}
+
+// RotateIntVector rotates the integer 3-vector v = (x, y, z) by the unit Hurwitz integer, i.e. it returns
+// the vector part of h * (xi + yj + zk) * conj(h)
+// The Hurwitz integer must be a unit so that the result is again an integer vector, otherwise ErrNotUnit is returned
+// The 24 units realize the 12 rotational symmetries of the regular tetrahedron inscribed in the cube,
+// each rotation twice as h and -h give the same rotation
+func (h *HurwitzInt) RotateIntVector(v [3]*big.Int) ([3]*big.Int, error) {
+	if !h.IsUnit() {
+		return [3]*big.Int{}, ErrNotUnit
+	}
+	rot := hiPool.Get().(*HurwitzInt).Update(big0, v[0], v[1], v[2], false)
+	defer hiPool.Put(rot)
+	hConj := hiPool.Get().(*HurwitzInt).Conj(h)
+	defer hiPool.Put(hConj)
+	rot.Prod(h, rot)
+	rot.Prod(rot, hConj)
+	return [3]*big.Int{
+		new(big.Int).Rsh(rot.dblI, 1),
+		new(big.Int).Rsh(rot.dblJ, 1),
+		new(big.Int).Rsh(rot.dblK, 1),
+	}, nil
+}
+
+// CloneHurwitzInts returns a new slice of deep copies of the Hurwitz integers, made with Copy for each element,
+// so that the copies can be mutated without affecting the originals, e.g. when handing them to other goroutines
+// nil elements stay nil, and a nil slice gives a nil slice
+func CloneHurwitzInts(xs []*HurwitzInt) []*HurwitzInt {
+	if xs == nil {
+		return nil
+	}
+	res := make([]*HurwitzInt, len(xs))
+	for i, x := range xs {
+		if x != nil {
+			res[i] = x.Copy()
+		}
+	}
+	return res
+}
+
+// HurwitzPrimeOfNorm returns a Hurwitz integer with norm equal to the rational prime p,
+// which shows that p is a sum of four squares (Lagrange's four-square theorem)
+// It finds a and b with a^2 + b^2 + 1 = 0 (mod p), so that p divides the norm of a + bi + j but not a + bi + j
+// itself, and returns the GCRD of p and a + bi + j, whose norm is exactly p
+// ErrNotPrime is returned if p is not a prime
+func HurwitzPrimeOfNorm(p *big.Int) (*HurwitzInt, error) {
+	if p.Sign() <= 0 || !p.ProbablyPrime(primalityRounds) {
+		return nil, ErrNotPrime
+	}
+	a, b := new(big.Int), new(big.Int)
+	if p.Cmp(big2) == 0 {
+		a.SetInt64(1)
+	} else {
+		// half of the residues are quadratic residues, so a suitable a is found after a few tries
+		rest := new(big.Int)
+		for ; ; a.Add(a, big1) {
+			rest.Mul(a, a)
+			rest.Add(rest, big1)
+			rest.Neg(rest)
+			rest.Mod(rest, p)
+			if b.ModSqrt(rest, p) != nil {
+				break
+			}
+		}
+	}
+	x := NewHurwitzInt(a, b, big1, big0, false)
+	return new(HurwitzInt).GCRD(NewHurwitzInt(p, big0, big0, big0, false), x), nil
 }
@@ -2,6 +2,7 @@ package complex
 
 import (
 	"math/big"
+	"math/rand"
 	"reflect"
 	"testing"
 )
@@ -198,3 +199,1066 @@ func TestHurwitzInt_ValInt(t *testing.T) {
 		})
 	}
 }
+
+func randHurwitzInt(r *rand.Rand, limit int64) *HurwitzInt {
+	parity := r.Int63n(2)
+	scalars := [4]*big.Int{}
+	for idx := range scalars {
+		scalars[idx] = big.NewInt(2*(r.Int63n(2*limit+1)-limit) + parity)
+	}
+	return NewHurwitzInt(scalars[0], scalars[1], scalars[2], scalars[3], true)
+}
+
+func TestHurwitzInt_Div(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for n := 0; n < 1000; n++ {
+		a := randHurwitzInt(r, 1000)
+		b := randHurwitzInt(r, 50)
+		if b.IsZero() {
+			continue
+		}
+		remainder := new(HurwitzInt)
+		quotient := remainder.Div(a, b)
+		if remainder.CmpNorm(b) >= 0 {
+			t.Fatalf("Div(%v, %v) remainder = %v, norm not smaller than divisor", a, b, remainder)
+		}
+		got := new(HurwitzInt).Prod(quotient, b)
+		got.Add(got, remainder)
+		if !got.Equals(a) {
+			t.Fatalf("Div(%v, %v) = %v, remainder %v, quotient * b + remainder = %v", a, b, quotient, remainder, got)
+		}
+	}
+}
+
+func TestHurwitzInt_Append(t *testing.T) {
+	buf := []byte("h = ")
+	h := NewHurwitzInt(big.NewInt(3), big.NewInt(1), big.NewInt(1), big.NewInt(5), true)
+	if got := string(h.Append(buf)); got != "h = 1.5+0.5i+0.5j+2.5k" {
+		t.Errorf("Append() = %v, want %v", got, "h = 1.5+0.5i+0.5j+2.5k")
+	}
+}
+
+func TestRandHurwitzUnit(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	seen := make(map[[4]int64]int)
+	for n := 0; n < 2400; n++ {
+		u := RandHurwitzUnit(r)
+		if u.Norm().Cmp(big.NewInt(1)) != 0 {
+			t.Fatalf("RandHurwitzUnit() = %v, norm %v, want 1", u, u.Norm())
+		}
+		seen[[4]int64{u.dblR.Int64(), u.dblI.Int64(), u.dblJ.Int64(), u.dblK.Int64()}]++
+	}
+	if len(seen) != 24 {
+		t.Errorf("RandHurwitzUnit() drew %d distinct units, want 24", len(seen))
+	}
+}
+
+func TestHurwitzInt_R(t *testing.T) {
+	h := NewHurwitzInt(big.NewInt(-3), big.NewInt(5), big.NewInt(1), big.NewInt(-1), true)
+	l := NewHurwitzInt(big.NewInt(-4), big.NewInt(4), big.NewInt(0), big.NewInt(2), true)
+	tests := []struct {
+		name string
+		got  *big.Rat
+		want *big.Rat
+	}{
+		{"test_R", h.R(), big.NewRat(-3, 2)},
+		{"test_I", h.I(), big.NewRat(5, 2)},
+		{"test_J", h.J(), big.NewRat(1, 2)},
+		{"test_K", h.K(), big.NewRat(-1, 2)},
+		{"test_R_lipschitz", l.R(), big.NewRat(-2, 1)},
+		{"test_I_lipschitz", l.I(), big.NewRat(2, 1)},
+		{"test_J_lipschitz", l.J(), big.NewRat(0, 1)},
+		{"test_K_lipschitz", l.K(), big.NewRat(1, 1)},
+		{"test_zero_value", new(HurwitzInt).R(), big.NewRat(0, 1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got.Cmp(tt.want) != 0 {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHurwitzInt_Equals(t *testing.T) {
+	tests := []struct {
+		name string
+		h    *HurwitzInt
+		a    *HurwitzInt
+		want bool
+	}{
+		{
+			name: "test_nil==0",
+			h:    &HurwitzInt{},
+			a:    NewHurwitzInt(big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), false),
+			want: true,
+		},
+		{
+			name: "test_0==nil",
+			h:    NewHurwitzInt(big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), false),
+			a:    &HurwitzInt{},
+			want: true,
+		},
+		{
+			name: "test_nil!=1",
+			h:    &HurwitzInt{},
+			a:    NewHurwitzInt(big.NewInt(1), big.NewInt(0), big.NewInt(0), big.NewInt(0), false),
+			want: false,
+		},
+		{
+			name: "test_1+i+j+k==1+i+j+k",
+			h:    NewHurwitzInt(big.NewInt(1), big.NewInt(1), big.NewInt(1), big.NewInt(1), false),
+			a:    NewHurwitzInt(big.NewInt(2), big.NewInt(2), big.NewInt(2), big.NewInt(2), true),
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.h.Equals(tt.a); got != tt.want {
+				t.Errorf("Equals() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHurwitzInt_Trace(t *testing.T) {
+	tests := []struct {
+		name string
+		h    *HurwitzInt
+		want *big.Int
+	}{
+		{
+			name: "test_1+i+j+k",
+			h:    NewHurwitzInt(big.NewInt(1), big.NewInt(1), big.NewInt(1), big.NewInt(1), false),
+			want: big.NewInt(2),
+		},
+		{
+			name: "test_-0.5+0.5i+0.5j+0.5k",
+			h:    NewHurwitzInt(big.NewInt(-1), big.NewInt(1), big.NewInt(1), big.NewInt(1), true),
+			want: big.NewInt(-1),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.h.Trace(); got.Cmp(tt.want) != 0 {
+				t.Errorf("Trace() = %v, want %v", got, tt.want)
+			}
+			// h satisfies its minimal polynomial x^2 - Trace(h) x + Norm(h) = 0
+			sq := new(HurwitzInt).Prod(tt.h, tt.h)
+			lin := new(HurwitzInt).Prod(NewHurwitzInt(tt.want, big.NewInt(0), big.NewInt(0), big.NewInt(0), false), tt.h)
+			sq.Sub(sq, lin)
+			sq.Add(sq, NewHurwitzInt(tt.h.Norm(), big.NewInt(0), big.NewInt(0), big.NewInt(0), false))
+			if !sq.IsZero() {
+				t.Errorf("h^2 - Trace(h) h + Norm(h) = %v, want 0", sq)
+			}
+		})
+	}
+}
+
+func TestHurwitzInt_ConjInPlace(t *testing.T) {
+	h := NewHurwitzInt(big.NewInt(1), big.NewInt(-3), big.NewInt(5), big.NewInt(-7), true)
+	if got := h.Copy().ConjInPlace(); !got.Equals(new(HurwitzInt).Conj(h)) {
+		t.Errorf("ConjInPlace() = %v, want %v", got, new(HurwitzInt).Conj(h))
+	}
+	if got := h.Copy().ConjInPlace().ConjInPlace(); !got.Equals(h) {
+		t.Errorf("ConjInPlace().ConjInPlace() = %v, want %v", got, h)
+	}
+	if got := new(HurwitzInt).ConjInPlace(); !got.IsZero() || got.dblR == nil || got.dblK == nil {
+		t.Errorf("ConjInPlace() = %v, want initialized 0", got)
+	}
+}
+
+func TestHurwitzInt_Dot(t *testing.T) {
+	tests := []struct {
+		name string
+		h    *HurwitzInt
+		a    *HurwitzInt
+		want *big.Rat
+	}{
+		{
+			name: "test_i.j",
+			h:    HurwitzI(),
+			a:    HurwitzJ(),
+			want: big.NewRat(0, 1),
+		},
+		{
+			name: "test_(1+2i+3j+4k).(1-i+j-k)",
+			h:    NewHurwitzInt(big.NewInt(1), big.NewInt(2), big.NewInt(3), big.NewInt(4), false),
+			a:    NewHurwitzInt(big.NewInt(1), big.NewInt(-1), big.NewInt(1), big.NewInt(-1), false),
+			want: big.NewRat(-2, 1),
+		},
+		{
+			name: "test_(0.5+0.5i+0.5j+0.5k).1",
+			h:    NewHurwitzInt(big.NewInt(1), big.NewInt(1), big.NewInt(1), big.NewInt(1), true),
+			a:    HurwitzOne(),
+			want: big.NewRat(1, 2),
+		},
+		{
+			name: "test_(0.5+0.5i+0.5j+0.5k).(0.5+0.5i+0.5j-0.5k)",
+			h:    NewHurwitzInt(big.NewInt(1), big.NewInt(1), big.NewInt(1), big.NewInt(1), true),
+			a:    NewHurwitzInt(big.NewInt(1), big.NewInt(1), big.NewInt(1), big.NewInt(-1), true),
+			want: big.NewRat(1, 2),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.h.Dot(tt.a); got.Cmp(tt.want) != 0 {
+				t.Errorf("Dot() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHurwitzInt_Commutator(t *testing.T) {
+	type args struct {
+		a *HurwitzInt
+		b *HurwitzInt
+	}
+	tests := []struct {
+		name     string
+		args     args
+		want     *HurwitzInt
+		wantAnti *HurwitzInt
+	}{
+		{
+			name: "test_[3,-5]",
+			args: args{
+				a: NewHurwitzInt(big.NewInt(3), big.NewInt(0), big.NewInt(0), big.NewInt(0), false),
+				b: NewHurwitzInt(big.NewInt(-5), big.NewInt(0), big.NewInt(0), big.NewInt(0), false),
+			},
+			want:     NewHurwitzInt(big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), false),
+			wantAnti: NewHurwitzInt(big.NewInt(-30), big.NewInt(0), big.NewInt(0), big.NewInt(0), false),
+		},
+		{
+			name: "test_[i,j]",
+			args: args{
+				a: HurwitzI(),
+				b: HurwitzJ(),
+			},
+			want:     NewHurwitzInt(big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(2), false),
+			wantAnti: NewHurwitzInt(big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), false),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := new(HurwitzInt).Commutator(tt.args.a, tt.args.b); !got.Equals(tt.want) {
+				t.Errorf("Commutator() = %v, want %v", got, tt.want)
+			}
+			if got := new(HurwitzInt).Anticommutator(tt.args.a, tt.args.b); !got.Equals(tt.wantAnti) {
+				t.Errorf("Anticommutator() = %v, want %v", got, tt.wantAnti)
+			}
+		})
+	}
+}
+
+func TestHurwitzInt_Associates(t *testing.T) {
+	tests := []struct {
+		name string
+		h    *HurwitzInt
+		want int
+	}{
+		{
+			name: "test_1+2i+3j+4k",
+			h:    NewHurwitzInt(big.NewInt(1), big.NewInt(2), big.NewInt(3), big.NewInt(4), false),
+			want: 24,
+		},
+		{
+			name: "test_0.5+1.5i-0.5j+0.5k",
+			h:    NewHurwitzInt(big.NewInt(1), big.NewInt(3), big.NewInt(-1), big.NewInt(1), true),
+			want: 24,
+		},
+		{
+			name: "test_0",
+			h:    NewHurwitzInt(big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), false),
+			want: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, left := range []bool{true, false} {
+				associates := tt.h.Associates(left)
+				if len(associates) != tt.want {
+					t.Errorf("Associates(%v) returned %d associates, want %d", left, len(associates), tt.want)
+				}
+				for _, a := range associates {
+					if a.CmpNorm(tt.h) != 0 {
+						t.Errorf("Associates(%v) contains %v with norm %v, want %v", left, a, a.Norm(), tt.h.Norm())
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestHurwitzInt_IsOne(t *testing.T) {
+	tests := []struct {
+		name     string
+		h        *HurwitzInt
+		want     bool
+		wantUnit bool
+	}{
+		{
+			name:     "test_1",
+			h:        HurwitzOne(),
+			want:     true,
+			wantUnit: true,
+		},
+		{
+			name:     "test_2",
+			h:        NewHurwitzInt(big.NewInt(2), big.NewInt(0), big.NewInt(0), big.NewInt(0), false),
+			want:     false,
+			wantUnit: false,
+		},
+		{
+			name:     "test_-k",
+			h:        NewHurwitzInt(big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(-1), false),
+			want:     false,
+			wantUnit: true,
+		},
+		{
+			name:     "test_0.5-0.5i+0.5j-0.5k",
+			h:        NewHurwitzInt(big.NewInt(1), big.NewInt(-1), big.NewInt(1), big.NewInt(-1), true),
+			want:     false,
+			wantUnit: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.h.IsOne(); got != tt.want {
+				t.Errorf("IsOne() = %v, want %v", got, tt.want)
+			}
+			if got := tt.h.IsUnit(); got != tt.wantUnit {
+				t.Errorf("IsUnit() = %v, want %v", got, tt.wantUnit)
+			}
+		})
+	}
+}
+
+func TestHurwitzInt_Mod(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	for n := 0; n < 1000; n++ {
+		a := randHurwitzInt(r, 1000)
+		b := randHurwitzInt(r, 50)
+		if b.IsZero() {
+			continue
+		}
+		remainder := new(HurwitzInt)
+		remainder.Div(a, b)
+		if got := new(HurwitzInt).Mod(a, b); !got.Equals(remainder) {
+			t.Fatalf("Mod(%v, %v) = %v, want %v", a, b, got, remainder)
+		}
+		got := new(HurwitzInt).ModLeft(a, b)
+		if got.CmpNorm(b) >= 0 {
+			t.Fatalf("ModLeft(%v, %v) = %v, norm not smaller than divisor", a, b, got)
+		}
+		// a - r must be a left multiple b * q, i.e. conj(b) * (a - r) is divisible by N(b)
+		diff := new(HurwitzInt).Sub(a, got)
+		diff.Prod(new(HurwitzInt).Conj(b), diff)
+		norm := b.Norm()
+		for _, dbl := range []*big.Int{diff.dblR, diff.dblI, diff.dblJ, diff.dblK} {
+			if new(big.Int).Mod(dbl, norm).Sign() != 0 {
+				t.Fatalf("ModLeft(%v, %v) = %v, a - r is not a left multiple of b", a, b, got)
+			}
+		}
+	}
+}
+
+func TestHurwitzInt_BitLen(t *testing.T) {
+	tests := []struct {
+		name string
+		h    *HurwitzInt
+		want int
+	}{
+		{
+			name: "test_0",
+			h:    NewHurwitzInt(big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), false),
+			want: 0,
+		},
+		{
+			name: "test_1-255k",
+			h:    NewHurwitzInt(big.NewInt(1), big.NewInt(0), big.NewInt(0), big.NewInt(-255), false),
+			want: 9,
+		},
+		{
+			name: "test_0.5+0.5i+0.5j+0.5k",
+			h:    NewHurwitzInt(big.NewInt(1), big.NewInt(1), big.NewInt(1), big.NewInt(1), true),
+			want: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.h.BitLen(); got != tt.want {
+				t.Errorf("BitLen() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHurwitzInt_GCRD(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+	for n := 0; n < 200; n++ {
+		// large quaternions with a known common right divisor
+		d := randHurwitzInt(r, 1<<20)
+		if d.IsZero() {
+			continue
+		}
+		a := new(HurwitzInt).Prod(randHurwitzInt(r, 1<<40), d)
+		b := new(HurwitzInt).Prod(randHurwitzInt(r, 1<<40), d)
+		if a.IsZero() || b.IsZero() {
+			continue
+		}
+		gcrd := new(HurwitzInt).GCRD(a, b)
+		// both inputs must be right multiples of the GCRD
+		for _, x := range []*HurwitzInt{a, b} {
+			if rem := new(HurwitzInt).Mod(x, gcrd); !rem.IsZero() {
+				t.Fatalf("GCRD(%v, %v) = %v, does not right-divide %v", a, b, gcrd, x)
+			}
+		}
+		// the GCRD must be a right multiple of the common right divisor d
+		if rem := new(HurwitzInt).Mod(gcrd, d); !rem.IsZero() {
+			t.Fatalf("GCRD(%v, %v) = %v, not right-divisible by %v", a, b, gcrd, d)
+		}
+	}
+	a := NewHurwitzInt(big.NewInt(1), big.NewInt(2), big.NewInt(3), big.NewInt(4), false)
+	zero := new(HurwitzInt).Init()
+	if got := new(HurwitzInt).GCRD(a, zero); !got.Equals(a) {
+		t.Errorf("GCRD(%v, 0) = %v, want %v", a, got, a)
+	}
+	if got := new(HurwitzInt).GCRD(zero, a); !got.Equals(a) {
+		t.Errorf("GCRD(0, %v) = %v, want %v", a, got, a)
+	}
+}
+
+func TestEqualHurwitz(t *testing.T) {
+	zero := NewHurwitzInt(big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), false)
+	tests := []struct {
+		name string
+		a    *HurwitzInt
+		b    *HurwitzInt
+		want bool
+	}{
+		{"test_nil_nil", nil, nil, true},
+		{"test_nil_0", nil, zero, false},
+		{"test_0_nil", zero, nil, false},
+		{"test_zero_value_0", &HurwitzInt{}, zero, true},
+		{"test_0_zero_value", zero, &HurwitzInt{}, true},
+		{"test_zero_value_zero_value", &HurwitzInt{}, &HurwitzInt{}, true},
+		{"test_zero_value_k", &HurwitzInt{}, HurwitzK(), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EqualHurwitz(tt.a, tt.b); got != tt.want {
+				t.Errorf("EqualHurwitz() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHurwitzInt_ZeroValue(t *testing.T) {
+	a := NewHurwitzInt(big.NewInt(1), big.NewInt(2), big.NewInt(3), big.NewInt(4), false)
+	if got := (&HurwitzInt{}).String(); got != "0" {
+		t.Errorf("String() = %v, want 0", got)
+	}
+	if got := (&HurwitzInt{}).Norm(); got.Sign() != 0 {
+		t.Errorf("Norm() = %v, want 0", got)
+	}
+	if !(&HurwitzInt{}).IsZero() {
+		t.Errorf("IsZero() = false, want true")
+	}
+	if got := new(HurwitzInt).Add(&HurwitzInt{}, a); !got.Equals(a) {
+		t.Errorf("Add() = %v, want %v", got, a)
+	}
+	if got := new(HurwitzInt).Add(a, &HurwitzInt{}); !got.Equals(a) {
+		t.Errorf("Add() = %v, want %v", got, a)
+	}
+	if got := new(HurwitzInt).Sub(a, &HurwitzInt{}); !got.Equals(a) {
+		t.Errorf("Sub() = %v, want %v", got, a)
+	}
+	if got := new(HurwitzInt).Prod(&HurwitzInt{}, a); !got.IsZero() {
+		t.Errorf("Prod() = %v, want 0", got)
+	}
+	if got := new(HurwitzInt).Prod(a, &HurwitzInt{}); !got.IsZero() {
+		t.Errorf("Prod() = %v, want 0", got)
+	}
+	if got := HurwitzZero(); !got.Equals(&HurwitzInt{}) || got.String() != "0" {
+		t.Errorf("HurwitzZero() = %v, want 0", got)
+	}
+}
+
+func TestHurwitzInt_RotateIntVector(t *testing.T) {
+	counts := make(map[[3]int64]int)
+	for _, u := range HurwitzUnits() {
+		got, err := u.RotateIntVector([3]*big.Int{big.NewInt(1), big.NewInt(0), big.NewInt(0)})
+		if err != nil {
+			t.Fatalf("RotateIntVector() error = %v", err)
+		}
+		counts[[3]int64{got[0].Int64(), got[1].Int64(), got[2].Int64()}]++
+	}
+	faces := [][3]int64{{1, 0, 0}, {-1, 0, 0}, {0, 1, 0}, {0, -1, 0}, {0, 0, 1}, {0, 0, -1}}
+	if len(counts) != len(faces) {
+		t.Fatalf("RotateIntVector() images = %v, want the 6 face directions", counts)
+	}
+	for _, f := range faces {
+		if counts[f] != 4 {
+			t.Errorf("RotateIntVector() images = %v, want each face direction 4 times", counts)
+		}
+	}
+
+	// the rotation by (1+i+j+k)/2 cycles the coordinate axes
+	u := NewHurwitzInt(big.NewInt(1), big.NewInt(1), big.NewInt(1), big.NewInt(1), true)
+	got, err := u.RotateIntVector([3]*big.Int{big.NewInt(3), big.NewInt(-5), big.NewInt(7)})
+	if err != nil || got[0].Int64() != 7 || got[1].Int64() != 3 || got[2].Int64() != -5 {
+		t.Errorf("RotateIntVector() = %v, %v, want [7 3 -5]", got, err)
+	}
+
+	h := NewHurwitzInt(big.NewInt(1), big.NewInt(1), big.NewInt(0), big.NewInt(0), false)
+	if _, err := h.RotateIntVector([3]*big.Int{big.NewInt(1), big.NewInt(0), big.NewInt(0)}); err != ErrNotUnit {
+		t.Errorf("RotateIntVector() error = %v, want %v", err, ErrNotUnit)
+	}
+}
+
+func TestHurwitzInt_Reset(t *testing.T) {
+	h := NewHurwitzInt(big.NewInt(1), big.NewInt(-3), big.NewInt(5), big.NewInt(7), true)
+	r, i, j, k := h.dblR, h.dblI, h.dblJ, h.dblK
+	if got := h.Reset(); !got.IsZero() || got != h {
+		t.Errorf("Reset() = %v, want 0", got)
+	}
+	if h.dblR != r || h.dblI != i || h.dblJ != j || h.dblK != k {
+		t.Errorf("Reset() reallocated the underlying big integers")
+	}
+	if got := new(HurwitzInt).Reset(); !got.IsZero() || got.dblR == nil {
+		t.Errorf("Reset() = %v, want initialized 0", got)
+	}
+}
+
+func TestHurwitzInt_Zero(t *testing.T) {
+	h := NewHurwitzInt(big.NewInt(1), big.NewInt(-3), big.NewInt(5), big.NewInt(7), true)
+	r := h.dblR
+	if got := h.Zero(); !got.IsZero() || h.dblR != r {
+		t.Errorf("Zero() = %v, want 0 reusing the underlying big integers", got)
+	}
+	if allocs := testing.AllocsPerRun(100, func() { h.Zero() }); allocs != 0 {
+		t.Errorf("Zero() allocs = %v, want 0", allocs)
+	}
+}
+
+func TestHurwitzInt_IsAssociate(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for n := 0; n < 200; n++ {
+		h := randHurwitzInt(r, 100)
+		if h.IsZero() {
+			continue
+		}
+		for _, u := range HurwitzUnits() {
+			right := new(HurwitzInt).Prod(h, u)
+			left := new(HurwitzInt).Prod(u, h)
+			if !h.IsRightAssociate(right) {
+				t.Fatalf("IsRightAssociate(%v, %v) = false, want true", h, right)
+			}
+			if !h.IsLeftAssociate(left) {
+				t.Fatalf("IsLeftAssociate(%v, %v) = false, want true", h, left)
+			}
+		}
+	}
+
+	// i * (1+2j) = i+2k, but (1+2j)^-1 * (i+2k) = (-3i+4k)/5 is not a unit
+	h := NewHurwitzInt(big.NewInt(1), big.NewInt(0), big.NewInt(2), big.NewInt(0), false)
+	a := NewHurwitzInt(big.NewInt(0), big.NewInt(1), big.NewInt(0), big.NewInt(2), false)
+	if !h.IsLeftAssociate(a) {
+		t.Errorf("IsLeftAssociate(%v, %v) = false, want true", h, a)
+	}
+	if h.IsRightAssociate(a) {
+		t.Errorf("IsRightAssociate(%v, %v) = true, want false", h, a)
+	}
+
+	// equal norms are not enough: 1+2j and 2+j both have norm 5
+	b := NewHurwitzInt(big.NewInt(2), big.NewInt(0), big.NewInt(1), big.NewInt(0), false)
+	if h.IsRightAssociate(b) || h.IsLeftAssociate(b) {
+		t.Errorf("IsAssociate(%v, %v) = true, want false", h, b)
+	}
+	if !HurwitzZero().IsRightAssociate(HurwitzZero()) || HurwitzZero().IsLeftAssociate(HurwitzOne()) {
+		t.Errorf("IsAssociate() on zero is wrong")
+	}
+}
+
+func TestCloneHurwitzInts(t *testing.T) {
+	xs := []*HurwitzInt{NewHurwitzInt(big.NewInt(1), big.NewInt(3), big.NewInt(5), big.NewInt(7), true), nil}
+	got := CloneHurwitzInts(xs)
+	if len(got) != len(xs) || got[1] != nil || got[0] == xs[0] || !got[0].Equals(xs[0]) {
+		t.Fatalf("CloneHurwitzInts() = %v, want deep copies of %v", got, xs)
+	}
+	got[0].dblR.SetInt64(9)
+	if xs[0].dblR.Int64() != 1 {
+		t.Errorf("CloneHurwitzInts() shares big integers with the original")
+	}
+	if CloneHurwitzInts(nil) != nil {
+		t.Errorf("CloneHurwitzInts(nil) != nil")
+	}
+}
+
+func TestHurwitzInt_IntComponents(t *testing.T) {
+	tests := []struct {
+		name      string
+		h         *HurwitzInt
+		want      [4]int64
+		wantExact bool
+	}{
+		{"test_2+4i+6j+8k", NewHurwitzInt(big.NewInt(2), big.NewInt(4), big.NewInt(6), big.NewInt(8), false), [4]int64{2, 4, 6, 8}, true},
+		{"test_1+2i+3j+4k_doubled", NewHurwitzInt(big.NewInt(2), big.NewInt(4), big.NewInt(6), big.NewInt(8), true), [4]int64{1, 2, 3, 4}, true},
+		{"test_-1-i+j", NewHurwitzInt(big.NewInt(-1), big.NewInt(-1), big.NewInt(1), big.NewInt(0), false), [4]int64{-1, -1, 1, 0}, true},
+		{"test_half", NewHurwitzInt(big.NewInt(3), big.NewInt(-3), big.NewInt(1), big.NewInt(-5), true), [4]int64{1, -1, 0, -2}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, i, j, k, exact := tt.h.IntComponents()
+			got := [4]int64{r.Int64(), i.Int64(), j.Int64(), k.Int64()}
+			if got != tt.want || exact != tt.wantExact {
+				t.Errorf("IntComponents() = %v, %v, want %v, %v", got, exact, tt.want, tt.wantExact)
+			}
+			vr, vi, vj, vk := tt.h.ValInt()
+			if want := [4]int64{vr.Int64(), vi.Int64(), vj.Int64(), vk.Int64()}; got != want {
+				t.Errorf("IntComponents() = %v, ValInt() = %v", got, want)
+			}
+		})
+	}
+}
+
+func TestHurwitzInt_Mul(t *testing.T) {
+	a := NewHurwitzInt(big.NewInt(1), big.NewInt(3), big.NewInt(-5), big.NewInt(7), true)
+	b := NewHurwitzInt(big.NewInt(2), big.NewInt(0), big.NewInt(-1), big.NewInt(4), false)
+	h := new(HurwitzInt)
+	if got, want := h.Mul(a, b), new(HurwitzInt).Prod(a, b); got != h || !got.Equals(want) {
+		t.Errorf("Mul() = %v, want %v", got, want)
+	}
+}
+
+func TestHurwitzSum(t *testing.T) {
+	tests := []struct {
+		name string
+		xs   []*HurwitzInt
+		want *HurwitzInt
+	}{
+		{
+			name: "test_empty",
+			xs:   nil,
+			want: NewHurwitzInt(big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), false),
+		},
+		{
+			name: "test_(1+2i-j+3k)",
+			xs: []*HurwitzInt{
+				NewHurwitzInt(big.NewInt(1), big.NewInt(2), big.NewInt(-1), big.NewInt(3), false),
+			},
+			want: NewHurwitzInt(big.NewInt(1), big.NewInt(2), big.NewInt(-1), big.NewInt(3), false),
+		},
+		{
+			name: "test_half_integers",
+			xs: []*HurwitzInt{
+				NewHurwitzInt(big.NewInt(1), big.NewInt(1), big.NewInt(1), big.NewInt(1), true),
+				NewHurwitzInt(big.NewInt(1), big.NewInt(-1), big.NewInt(3), big.NewInt(-5), true),
+				NewHurwitzInt(big.NewInt(2), big.NewInt(0), big.NewInt(0), big.NewInt(1), false),
+			},
+			want: NewHurwitzInt(big.NewInt(3), big.NewInt(0), big.NewInt(2), big.NewInt(-1), false),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HurwitzSum(tt.xs...); !got.Equals(tt.want) {
+				t.Errorf("HurwitzSum() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHurwitzProduct(t *testing.T) {
+	tests := []struct {
+		name string
+		xs   []*HurwitzInt
+		want *HurwitzInt
+	}{
+		{
+			name: "test_empty",
+			xs:   nil,
+			want: NewHurwitzInt(big.NewInt(1), big.NewInt(0), big.NewInt(0), big.NewInt(0), false),
+		},
+		{
+			name: "test_ij",
+			xs: []*HurwitzInt{
+				NewHurwitzInt(big.NewInt(0), big.NewInt(1), big.NewInt(0), big.NewInt(0), false),
+				NewHurwitzInt(big.NewInt(0), big.NewInt(0), big.NewInt(1), big.NewInt(0), false),
+			},
+			want: NewHurwitzInt(big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(1), false),
+		},
+		{
+			name: "test_ji",
+			xs: []*HurwitzInt{
+				NewHurwitzInt(big.NewInt(0), big.NewInt(0), big.NewInt(1), big.NewInt(0), false),
+				NewHurwitzInt(big.NewInt(0), big.NewInt(1), big.NewInt(0), big.NewInt(0), false),
+			},
+			want: NewHurwitzInt(big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(-1), false),
+		},
+		{
+			name: "test_ijk",
+			xs: []*HurwitzInt{
+				NewHurwitzInt(big.NewInt(0), big.NewInt(1), big.NewInt(0), big.NewInt(0), false),
+				NewHurwitzInt(big.NewInt(0), big.NewInt(0), big.NewInt(1), big.NewInt(0), false),
+				NewHurwitzInt(big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(1), false),
+			},
+			want: NewHurwitzInt(big.NewInt(-1), big.NewInt(0), big.NewInt(0), big.NewInt(0), false),
+		},
+		{
+			name: "test_(1+i+j+k)/2_(1-i-j-k)/2",
+			xs: []*HurwitzInt{
+				NewHurwitzInt(big.NewInt(1), big.NewInt(1), big.NewInt(1), big.NewInt(1), true),
+				NewHurwitzInt(big.NewInt(1), big.NewInt(-1), big.NewInt(-1), big.NewInt(-1), true),
+			},
+			want: NewHurwitzInt(big.NewInt(1), big.NewInt(0), big.NewInt(0), big.NewInt(0), false),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HurwitzProduct(tt.xs...); !got.Equals(tt.want) {
+				t.Errorf("HurwitzProduct() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHurwitzInt_AddMul(t *testing.T) {
+	tests := []struct {
+		name string
+		h    *HurwitzInt
+		a    *HurwitzInt
+		b    *HurwitzInt
+		want *HurwitzInt
+	}{
+		{
+			name: "test_1+ij",
+			h:    NewHurwitzInt(big.NewInt(1), big.NewInt(0), big.NewInt(0), big.NewInt(0), false),
+			a:    NewHurwitzInt(big.NewInt(0), big.NewInt(1), big.NewInt(0), big.NewInt(0), false),
+			b:    NewHurwitzInt(big.NewInt(0), big.NewInt(0), big.NewInt(1), big.NewInt(0), false),
+			want: NewHurwitzInt(big.NewInt(1), big.NewInt(0), big.NewInt(0), big.NewInt(1), false),
+		},
+		{
+			name: "test_1+ji",
+			h:    NewHurwitzInt(big.NewInt(1), big.NewInt(0), big.NewInt(0), big.NewInt(0), false),
+			a:    NewHurwitzInt(big.NewInt(0), big.NewInt(0), big.NewInt(1), big.NewInt(0), false),
+			b:    NewHurwitzInt(big.NewInt(0), big.NewInt(1), big.NewInt(0), big.NewInt(0), false),
+			want: NewHurwitzInt(big.NewInt(1), big.NewInt(0), big.NewInt(0), big.NewInt(-1), false),
+		},
+		{
+			name: "test_zero_value_half_integers",
+			h:    new(HurwitzInt),
+			a:    NewHurwitzInt(big.NewInt(1), big.NewInt(1), big.NewInt(1), big.NewInt(1), true),
+			b:    NewHurwitzInt(big.NewInt(1), big.NewInt(-1), big.NewInt(-1), big.NewInt(-1), true),
+			want: NewHurwitzInt(big.NewInt(1), big.NewInt(0), big.NewInt(0), big.NewInt(0), false),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := tt.h.Copy()
+			if got := h.AddMul(tt.a, tt.b); got != h || !got.Equals(tt.want) {
+				t.Errorf("AddMul() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for n := 0; n < 1000; n++ {
+		h, a, b := randHurwitzInt(r, 1<<40), randHurwitzInt(r, 1<<40), randHurwitzInt(r, 1<<40)
+		c, d := randHurwitzInt(r, 1<<40), randHurwitzInt(r, 1<<40)
+		want := new(HurwitzInt).Add(h, new(HurwitzInt).Prod(a, b))
+		if got := h.Copy().AddMul(a, b); !got.Equals(want) {
+			t.Fatalf("(%v).AddMul(%v, %v) = %v, want %v", h, a, b, got, want)
+		}
+		// the accumulated sum does not depend on the order of the terms
+		first := h.Copy().AddMul(a, b).AddMul(c, d)
+		second := h.Copy().AddMul(c, d).AddMul(a, b)
+		if !first.Equals(second) {
+			t.Fatalf("AddMul() accumulation order changes the sum: %v != %v", first, second)
+		}
+		// aliasing the receiver with either operand
+		want = new(HurwitzInt).Add(h, new(HurwitzInt).Prod(h, a))
+		if got := h.Copy(); !got.AddMul(got, a).Equals(want) {
+			t.Fatalf("(%v).AddMul(h, %v) = %v, want %v", h, a, got, want)
+		}
+		want = new(HurwitzInt).Add(h, new(HurwitzInt).Prod(a, h))
+		if got := h.Copy(); !got.AddMul(a, got).Equals(want) {
+			t.Fatalf("(%v).AddMul(%v, h) = %v, want %v", h, a, got, want)
+		}
+	}
+}
+
+func TestHurwitzPrimeOfNorm(t *testing.T) {
+	for _, p := range []int64{2, 3, 5, 7, 11, 13, 10007, 1000000007} {
+		got, err := HurwitzPrimeOfNorm(big.NewInt(p))
+		if err != nil || got.Norm().Int64() != p {
+			t.Errorf("HurwitzPrimeOfNorm(%d) = %v, %v, want a Hurwitz integer of norm %d", p, got, err, p)
+		}
+	}
+	for _, p := range []int64{-7, 0, 1, 9} {
+		if _, err := HurwitzPrimeOfNorm(big.NewInt(p)); err != ErrNotPrime {
+			t.Errorf("HurwitzPrimeOfNorm(%d) error = %v, want %v", p, err, ErrNotPrime)
+		}
+	}
+}
+
+func TestHurwitzInt_StringSigns(t *testing.T) {
+	tests := []struct {
+		name string
+		h    *HurwitzInt
+		want string
+	}{
+		{"test_large_negative_half_k", NewHurwitzInt(big.NewInt(1), big.NewInt(1), big.NewInt(1), big.NewInt(-123457), true), "0.5+0.5i+0.5j-61728.5k"},
+		{"test_negative_real", NewHurwitzInt(big.NewInt(-2), big.NewInt(1), big.NewInt(0), big.NewInt(0), false), "-2+i"},
+		{"test_negative_one_real", NewHurwitzInt(big.NewInt(-1), big.NewInt(0), big.NewInt(0), big.NewInt(3), false), "-1+3k"},
+		{"test_negative_real_positive_i", NewHurwitzInt(big.NewInt(-3), big.NewInt(3), big.NewInt(-3), big.NewInt(3), true), "-1.5+1.5i-1.5j+1.5k"},
+		{"test_positive_real_negative_i", NewHurwitzInt(big.NewInt(5), big.NewInt(-7), big.NewInt(1), big.NewInt(-1), true), "2.5-3.5i+0.5j-0.5k"},
+		{"test_negative_units", NewHurwitzInt(big.NewInt(0), big.NewInt(-1), big.NewInt(-1), big.NewInt(-1), false), "-i-j-k"},
+		{"test_negative_integers", NewHurwitzInt(big.NewInt(-4), big.NewInt(-5), big.NewInt(6), big.NewInt(-7), false), "-4-5i+6j-7k"},
+		{"test_negative_half_real_only", NewHurwitzInt(big.NewInt(-1), big.NewInt(1), big.NewInt(1), big.NewInt(1), true), "-0.5+0.5i+0.5j+0.5k"},
+		{
+			"test_huge_negative_half",
+			NewHurwitzInt(new(big.Int).Neg(new(big.Int).Add(new(big.Int).Lsh(big1, 201), big1)), big.NewInt(1), big.NewInt(-1), big.NewInt(1), true),
+			"-1606938044258990275541962092341162602522202993782792835301376.5+0.5i-0.5j+0.5k",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.h.String(); got != tt.want {
+				t.Errorf("String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHurwitzInt_Clone(t *testing.T) {
+	h := NewHurwitzInt(big.NewInt(1), big.NewInt(-3), big.NewInt(5), big.NewInt(7), true)
+	got := h.Clone()
+	if got == h || got.dblR == h.dblR || !got.Equals(h) {
+		t.Errorf("Clone() = %v, want a deep copy of %v", got, h)
+	}
+	got = new(HurwitzInt).Clone()
+	if got.dblR == nil || got.dblK == nil || !got.IsZero() {
+		t.Errorf("Clone() of the zero value = %v, want 0", got)
+	}
+}
+
+func TestHurwitzInt_CopyNilParts(t *testing.T) {
+	tests := []struct {
+		name string
+		h    *HurwitzInt
+		want *HurwitzInt
+	}{
+		{"test_zero_value", &HurwitzInt{}, HurwitzZero()},
+		{"test_nil_j_k", &HurwitzInt{dblR: big.NewInt(2), dblI: big.NewInt(-4)}, NewHurwitzInt(big.NewInt(1), big.NewInt(-2), big.NewInt(0), big.NewInt(0), false)},
+		{"test_nil_r", &HurwitzInt{dblI: big.NewInt(2), dblJ: big.NewInt(2), dblK: big.NewInt(2)}, NewHurwitzInt(big.NewInt(0), big.NewInt(1), big.NewInt(1), big.NewInt(1), false)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.h.Copy()
+			if got.dblR == nil || got.dblI == nil || got.dblJ == nil || got.dblK == nil || !got.Equals(tt.want) {
+				t.Errorf("Copy() = %v, want %v", got, tt.want)
+			}
+			if got := HurwitzOne().Set(tt.h); !got.Equals(tt.want) {
+				t.Errorf("Set() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHurwitzNormOfProduct(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for n := 0; n < 1000; n++ {
+		a := randHurwitzInt(r, 1<<40)
+		b := randHurwitzInt(r, 1<<40)
+		want := new(HurwitzInt).Prod(a, b).Norm()
+		if got := HurwitzNormOfProduct(a, b); got.Cmp(want) != 0 {
+			t.Fatalf("HurwitzNormOfProduct(%v, %v) = %v, want %v", a, b, got, want)
+		}
+	}
+}
+
+func TestHurwitzInt_ZeroValueQueries(t *testing.T) {
+	zero := new(HurwitzInt)
+	if !zero.Equals(HurwitzZero()) || !HurwitzZero().Equals(zero) || !EqualHurwitz(zero, HurwitzZero()) {
+		t.Errorf("zero value is not equal to HurwitzZero()")
+	}
+	if zero.Equals(HurwitzOne()) || zero.IsOne() || !zero.IsLipschitz() {
+		t.Errorf("zero value predicates are wrong")
+	}
+	if zero.Trace().Sign() != 0 || zero.BitLen() != 0 || zero.Dot(HurwitzOne()).Sign() != 0 {
+		t.Errorf("zero value queries are not zero")
+	}
+	if got := new(HurwitzInt).Conj(zero); !got.IsZero() {
+		t.Errorf("Conj() of the zero value = %v, want 0", got)
+	}
+	if got := zero.LaTeX(); got != "0" {
+		t.Errorf("LaTeX() of the zero value = %v, want 0", got)
+	}
+	r, _, _, k := zero.Val()
+	if r.Sign() != 0 || k.Sign() != 0 {
+		t.Errorf("Val() of the zero value = %v, %v, want 0", r, k)
+	}
+	if _, _, _, k, exact := zero.IntComponents(); k.Sign() != 0 || !exact {
+		t.Errorf("IntComponents() of the zero value = %v, %v, want 0, true", k, exact)
+	}
+
+	partial := &HurwitzInt{dblR: big.NewInt(2)}
+	if !partial.IsOne() || !partial.Equals(HurwitzOne()) || partial.BitLen() != 2 {
+		t.Errorf("partially initialized one is not equal to HurwitzOne()")
+	}
+}
+
+func TestHurwitzInt_IsReal(t *testing.T) {
+	tests := []struct {
+		name string
+		h    *HurwitzInt
+		want bool
+	}{
+		{"test_zero_value", &HurwitzInt{}, true},
+		{"test_real", NewHurwitzInt(big.NewInt(-3), big.NewInt(0), big.NewInt(0), big.NewInt(0), false), true},
+		{"test_nil_parts", &HurwitzInt{dblR: big.NewInt(4)}, true},
+		{"test_k", HurwitzK(), false},
+		{"test_half", NewHurwitzInt(big.NewInt(1), big.NewInt(1), big.NewInt(1), big.NewInt(1), true), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.h.IsReal(); got != tt.want {
+				t.Errorf("IsReal() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHurwitzInt_RoundToLipschitz(t *testing.T) {
+	tests := []struct {
+		name string
+		h    *HurwitzInt
+		want *HurwitzInt
+	}{
+		{"test_zero_value", &HurwitzInt{}, HurwitzZero()},
+		{"test_lipschitz", NewHurwitzInt(big.NewInt(3), big.NewInt(-1), big.NewInt(0), big.NewInt(7), false), NewHurwitzInt(big.NewInt(3), big.NewInt(-1), big.NewInt(0), big.NewInt(7), false)},
+		{"test_half_unit", NewHurwitzInt(big.NewInt(1), big.NewInt(1), big.NewInt(-1), big.NewInt(-1), true), HurwitzZero()},
+		{"test_half", NewHurwitzInt(big.NewInt(3), big.NewInt(-5), big.NewInt(1), big.NewInt(-7), true), NewHurwitzInt(big.NewInt(1), big.NewInt(-2), big.NewInt(0), big.NewInt(-3), false)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orig := tt.h.Copy()
+			got := tt.h.RoundToLipschitz()
+			if !got.Equals(tt.want) || !got.IsLipschitz() {
+				t.Errorf("RoundToLipschitz() = %v, want %v", got, tt.want)
+			}
+			if !tt.h.Equals(orig) {
+				t.Errorf("RoundToLipschitz() modified the receiver to %v", tt.h)
+			}
+			diff := new(HurwitzInt).Sub(tt.h, got)
+			if !diff.IsZero() && !diff.IsUnit() {
+				t.Errorf("RoundToLipschitz() difference %v is neither 0 nor a unit", diff)
+			}
+		})
+	}
+}
+
+func TestHurwitzInt_IsCoprime(t *testing.T) {
+	onePlusI := NewHurwitzInt(big.NewInt(1), big.NewInt(1), big.NewInt(0), big.NewInt(0), false)
+	tests := []struct {
+		name string
+		h    *HurwitzInt
+		a    *HurwitzInt
+		want bool
+	}{
+		{"test_odd_and_even", NewHurwitzInt(big.NewInt(3), big.NewInt(0), big.NewInt(0), big.NewInt(0), false), NewHurwitzInt(big.NewInt(2), big.NewInt(0), big.NewInt(0), big.NewInt(0), false), true},
+		{"test_ramified", onePlusI, NewHurwitzInt(big.NewInt(2), big.NewInt(0), big.NewInt(0), big.NewInt(0), false), false},
+		{"test_common_right_factor", new(HurwitzInt).Prod(HurwitzJ(), onePlusI), new(HurwitzInt).Prod(NewHurwitzInt(big.NewInt(3), big.NewInt(0), big.NewInt(0), big.NewInt(0), false), onePlusI), false},
+		{"test_unit", NewHurwitzInt(big.NewInt(1), big.NewInt(-1), big.NewInt(1), big.NewInt(1), true), NewHurwitzInt(big.NewInt(6), big.NewInt(0), big.NewInt(0), big.NewInt(0), false), true},
+		{"test_zero_and_unit", HurwitzZero(), HurwitzK(), true},
+		{"test_zeros", HurwitzZero(), HurwitzZero(), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.h.IsCoprime(tt.a); got != tt.want {
+				t.Errorf("IsCoprime() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHurwitzInt_UnitOrder(t *testing.T) {
+	tests := []struct {
+		name      string
+		h         *HurwitzInt
+		wantOrder int
+		wantOk    bool
+	}{
+		{"test_one", HurwitzOne(), 1, true},
+		{"test_minus_one", NewHurwitzInt(big.NewInt(-1), big.NewInt(0), big.NewInt(0), big.NewInt(0), false), 2, true},
+		{"test_i", HurwitzI(), 4, true},
+		{"test_minus_k", NewHurwitzInt(big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(-1), false), 4, true},
+		{"test_(1+i+j+k)/2", NewHurwitzInt(big.NewInt(1), big.NewInt(1), big.NewInt(1), big.NewInt(1), true), 6, true},
+		{"test_(1-i+j-k)/2", NewHurwitzInt(big.NewInt(1), big.NewInt(-1), big.NewInt(1), big.NewInt(-1), true), 6, true},
+		{"test_(-1+i+j+k)/2", NewHurwitzInt(big.NewInt(-1), big.NewInt(1), big.NewInt(1), big.NewInt(1), true), 3, true},
+		{"test_zero", HurwitzZero(), 0, false},
+		{"test_1+i", NewHurwitzInt(big.NewInt(1), big.NewInt(1), big.NewInt(0), big.NewInt(0), false), 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order, ok := tt.h.UnitOrder()
+			if order != tt.wantOrder || ok != tt.wantOk {
+				t.Errorf("UnitOrder() = %v, %v, want %v, %v", order, ok, tt.wantOrder, tt.wantOk)
+			}
+		})
+	}
+	// the orders of the 24 units: 1 of order 1, 1 of order 2, 8 of order 3, 6 of order 4, and 8 of order 6
+	counts := map[int]int{}
+	for _, u := range HurwitzUnits() {
+		order, _ := u.UnitOrder()
+		counts[order]++
+	}
+	if want := map[int]int{1: 1, 2: 1, 3: 8, 4: 6, 6: 8}; !reflect.DeepEqual(counts, want) {
+		t.Errorf("UnitOrder() counts over the units = %v, want %v", counts, want)
+	}
+}
+
+func TestHurwitzInt_GCRDWithQuotients(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	coprime := 0
+	for n := 0; n < 500; n++ {
+		a := randHurwitzInt(r, 1<<30)
+		b := randHurwitzInt(r, 1<<30)
+		h := new(HurwitzInt)
+		gcrd, quotients := h.GCRDWithQuotients(a, b)
+		if want := new(HurwitzInt).GCRD(a, b); !gcrd.Equals(want) || !h.Equals(want) {
+			t.Fatalf("GCRDWithQuotients(%v, %v) = %v, want %v", a, b, gcrd, want)
+		}
+		larger, smaller := a, b
+		if a.CmpNorm(b) < 0 {
+			larger, smaller = b, a
+		}
+		// replay r_(k-1) = q_k * r_k + r_(k+1) backwards from (gcrd, 0)
+		x, y := gcrd.Copy(), HurwitzZero()
+		// and accumulate the left Bezout cofactors of the pair (r_(k-1), r_k), starting from gcrd = 1 * gcrd + 0 * 0
+		sCo, tCo := HurwitzOne(), HurwitzZero()
+		for idx := len(quotients) - 1; idx >= 0; idx-- {
+			q := quotients[idx]
+			x, y = new(HurwitzInt).Add(new(HurwitzInt).Prod(q, x), y), x
+			// s * r_k + t * r_(k+1) = s * r_k + t * (r_(k-1) - q_k * r_k) = t * r_(k-1) + (s - t * q_k) * r_k
+			sCo, tCo = tCo, new(HurwitzInt).Sub(sCo, new(HurwitzInt).Prod(tCo, q))
+		}
+		if !x.Equals(larger) || !y.Equals(smaller) {
+			t.Fatalf("replaying %v gives %v, %v, want %v, %v", quotients, x, y, larger, smaller)
+		}
+		bezout := new(HurwitzInt).Add(new(HurwitzInt).Prod(sCo, larger), new(HurwitzInt).Prod(tCo, smaller))
+		if !bezout.Equals(gcrd) {
+			t.Fatalf("Bezout cofactors %v, %v give %v, want %v", sCo, tCo, bezout, gcrd)
+		}
+		if gcrd.IsUnit() {
+			coprime++
+		}
+	}
+	if coprime == 0 {
+		t.Errorf("no coprime pairs were tested")
+	}
+	if _, quotients := new(HurwitzInt).GCRDWithQuotients(HurwitzK(), HurwitzZero()); len(quotients) != 0 {
+		t.Errorf("GCRDWithQuotients(k, 0) quotients = %v, want none", quotients)
+	}
+}
@@ -0,0 +1,76 @@
+// MIT License
+//
+// Copyright (c) 2022 Tommy TIAN
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package complex
+
+import "math/big"
+
+// MinkowskiReduce returns a Minkowski-reduced basis of the lattice generated over Z by a and b,
+// viewing Gaussian integers as vectors in the plane, using the Gauss (Lagrange) lattice reduction algorithm
+// The first vector of the reduced basis is a shortest non-zero vector of the lattice, the second one is
+// a shortest vector independent of it, and |<u, v>| <= N(u) / 2 where <u, v> = Re(u * conj(v))
+// Each step subtracts the nearest-integer multiple of the shorter vector, so the norms never increase
+// If a and b are linearly dependent over the reals, the second vector becomes zero and the first
+// generates the lattice
+func MinkowskiReduce(a, b *GaussianInt) (*GaussianInt, *GaussianInt) {
+	u, v := a.Copy(), b.Copy()
+	uNorm, vNorm := u.Norm(), v.Norm()
+	if uNorm.Cmp(vNorm) > 0 {
+		u, v = v, u
+		uNorm, vNorm = vNorm, uNorm
+	}
+	dot := iPool.Get().(*big.Int)
+	defer iPool.Put(dot)
+	mu := iPool.Get().(*big.Int)
+	defer iPool.Put(mu)
+	for uNorm.Sign() != 0 {
+		// mu is <u, v> / N(u) rounded to the nearest integer, i.e. floor((2 * <u, v> + N(u)) / (2 * N(u)))
+		dot.Mul(u.R, v.R)
+		dot.Add(dot, mu.Mul(u.I, v.I))
+		dot.Lsh(dot, 1)
+		dot.Add(dot, uNorm)
+		mu.Lsh(uNorm, 1)
+		mu.Div(dot, mu)
+		v.R.Sub(v.R, dot.Mul(mu, u.R))
+		v.I.Sub(v.I, dot.Mul(mu, u.I))
+		vNorm = v.Norm()
+		if vNorm.Cmp(uNorm) >= 0 {
+			break
+		}
+		u, v = v, u
+		uNorm, vNorm = vNorm, uNorm
+	}
+	if uNorm.Sign() == 0 {
+		// a zero vector contributes nothing, so the other vector generates the lattice
+		u, v = v, u
+	}
+	return u, v
+}
+
+// ShortestVector returns a shortest non-zero vector of the lattice generated over Z by a and b,
+// i.e. the first vector of the basis given by MinkowskiReduce, whose norm is the minimum of the lattice
+// The shortest vector is unique only up to sign (or more for the square and hexagonal lattices)
+// If a and b are both zero, the lattice has no non-zero vector and the result is zero
+func ShortestVector(a, b *GaussianInt) *GaussianInt {
+	u, _ := MinkowskiReduce(a, b)
+	return u
+}
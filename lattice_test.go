@@ -0,0 +1,150 @@
+// MIT License
+//
+// Copyright (c) 2022 Tommy TIAN
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package complex
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func TestMinkowskiReduce(t *testing.T) {
+	// the determinant Im(conj(a) * b) is the signed area of the fundamental parallelogram
+	det := func(a, b *GaussianInt) *big.Int {
+		d := new(big.Int).Mul(a.R, b.I)
+		return d.Sub(d, new(big.Int).Mul(a.I, b.R))
+	}
+	tests := []struct {
+		name  string
+		a     *GaussianInt
+		b     *GaussianInt
+		wantU *GaussianInt
+		wantV *GaussianInt
+	}{
+		{
+			name:  "test_nearly_parallel",
+			a:     NewGaussianInt(big.NewInt(100), big.NewInt(1)),
+			b:     NewGaussianInt(big.NewInt(99), big.NewInt(1)),
+			wantU: NewGaussianInt(big.NewInt(1), big.NewInt(0)),
+			wantV: NewGaussianInt(big.NewInt(0), big.NewInt(1)),
+		},
+		{
+			name:  "test_dependent",
+			a:     NewGaussianInt(big.NewInt(6), big.NewInt(-4)),
+			b:     NewGaussianInt(big.NewInt(-9), big.NewInt(6)),
+			wantU: NewGaussianInt(big.NewInt(-3), big.NewInt(2)),
+			wantV: NewGaussianInt(big.NewInt(0), big.NewInt(0)),
+		},
+		{
+			name:  "test_zero",
+			a:     NewGaussianInt(big.NewInt(0), big.NewInt(0)),
+			b:     NewGaussianInt(big.NewInt(2), big.NewInt(5)),
+			wantU: NewGaussianInt(big.NewInt(2), big.NewInt(5)),
+			wantV: NewGaussianInt(big.NewInt(0), big.NewInt(0)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, v := MinkowskiReduce(tt.a, tt.b)
+			if !u.Equals(tt.wantU) || !v.Equals(tt.wantV) {
+				t.Errorf("MinkowskiReduce() = %v, %v, want %v, %v", u, v, tt.wantU, tt.wantV)
+			}
+		})
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for n := 0; n < 1000; n++ {
+		a := NewGaussianInt(big.NewInt(r.Int63()-r.Int63()), big.NewInt(r.Int63()-r.Int63()))
+		b := NewGaussianInt(big.NewInt(r.Int63()-r.Int63()), big.NewInt(r.Int63()-r.Int63()))
+		u, v := MinkowskiReduce(a, b)
+		minNorm, maxNorm := a.Norm(), b.Norm()
+		if minNorm.Cmp(maxNorm) > 0 {
+			minNorm, maxNorm = maxNorm, minNorm
+		}
+		if u.Norm().Cmp(minNorm) > 0 || v.Norm().Cmp(maxNorm) > 0 || u.Norm().Cmp(v.Norm()) > 0 {
+			t.Fatalf("MinkowskiReduce(%v, %v) = %v, %v, norms not reduced", a, b, u, v)
+		}
+		if got, want := det(u, v), det(a, b); new(big.Int).Abs(got).Cmp(new(big.Int).Abs(want)) != 0 {
+			t.Fatalf("MinkowskiReduce(%v, %v) = %v, %v, determinant %v, want +-%v", a, b, u, v, got, want)
+		}
+		dot := new(big.Int).Mul(u.R, v.R)
+		dot.Add(dot, new(big.Int).Mul(u.I, v.I))
+		if dot.Abs(dot).Lsh(dot, 1).Cmp(u.Norm()) > 0 {
+			t.Fatalf("MinkowskiReduce(%v, %v) = %v, %v, not size-reduced", a, b, u, v)
+		}
+	}
+}
+
+func TestShortestVector(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        *GaussianInt
+		b        *GaussianInt
+		wantNorm int64
+	}{
+		{
+			name:     "test_skewed_basis",
+			a:        NewGaussianInt(big.NewInt(10), big.NewInt(1)),
+			b:        NewGaussianInt(big.NewInt(21), big.NewInt(3)),
+			wantNorm: 2,
+		},
+		{
+			name:     "test_ideal_of_2+i",
+			a:        NewGaussianInt(big.NewInt(5), big.NewInt(5)),
+			b:        NewGaussianInt(big.NewInt(7), big.NewInt(6)),
+			wantNorm: 5,
+		},
+		{
+			name:     "test_dependent",
+			a:        NewGaussianInt(big.NewInt(6), big.NewInt(9)),
+			b:        NewGaussianInt(big.NewInt(-4), big.NewInt(-6)),
+			wantNorm: 13,
+		},
+		{
+			name:     "test_zero",
+			a:        NewGaussianInt(big.NewInt(0), big.NewInt(0)),
+			b:        NewGaussianInt(big.NewInt(0), big.NewInt(0)),
+			wantNorm: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ShortestVector(tt.a, tt.b)
+			if got.Norm().Cmp(big.NewInt(tt.wantNorm)) != 0 {
+				t.Fatalf("ShortestVector() = %v, norm %v, want norm %d", got, got.Norm(), tt.wantNorm)
+			}
+			// no small combination m * a + n * b may be shorter
+			ma, nb := new(GaussianInt), new(GaussianInt)
+			for m := int64(-20); m <= 20; m++ {
+				for n := int64(-20); n <= 20; n++ {
+					ma.Prod(tt.a, NewGaussianInt(big.NewInt(m), big0))
+					nb.Prod(tt.b, NewGaussianInt(big.NewInt(n), big0))
+					norm := ma.Add(ma, nb).Norm()
+					if norm.Sign() != 0 && norm.Cmp(got.Norm()) < 0 {
+						t.Fatalf("ShortestVector() = %v, but %d * a + %d * b = %v is shorter", got, m, n, ma)
+					}
+				}
+			}
+		})
+	}
+}
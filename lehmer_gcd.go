@@ -0,0 +1,137 @@
+// MIT License
+//
+// Copyright (c) 2022 Tommy TIAN
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package complex
+
+import (
+	"math"
+	"math/big"
+	"math/bits"
+)
+
+// lehmerBits is the number of leading bits of the operands used by LehmerGCD to simulate Euclidean steps,
+// chosen so that the simulation runs on int64 and float64 without overflow or loss of precision
+const lehmerBits = 52
+
+// LehmerGCD calculates the greatest common divisor of two Gaussian integers using the Euclidean algorithm
+// with Lehmer's acceleration: the Euclidean steps are first simulated on the leading lehmerBits bits of the
+// operands, as long as the simulated remainders keep more than half of those bits so that their quotients
+// are reliable, and the product of the simulated steps, a 2x2 matrix of small Gaussian integers, is then
+// applied to the full operands at once
+// Every step matrix has determinant -1, so the gcd is preserved even if a simulated quotient differs from
+// the true one; if a simulation fails to reduce the operands, a full Euclidean step is taken instead
+// Like GCD, the canonical associate given by Normalize is chosen as the result,
+// which is stored in the Gaussian integer that calls the method and returned
+func (g *GaussianInt) LehmerGCD(a, b *GaussianInt) *GaussianInt {
+	x := new(GaussianInt).Set(a)
+	y := new(GaussianInt).Set(b)
+	if x.CmpNorm(y) < 0 {
+		x, y = y, x
+	}
+	remainder := new(GaussianInt)
+	for !y.IsZero() {
+		if x.BitLen() > lehmerBits {
+			if nx, ny, ok := lehmerReduce(x, y); ok {
+				x, y = nx, ny
+				continue
+			}
+		}
+		remainder.Div(x, y)
+		x, y, remainder = y, remainder, x
+	}
+	g.Normalize(x)
+	return new(GaussianInt).Set(g)
+}
+
+// lehmerReduce simulates the Euclidean steps on the leading bits of x and y, where N(x) >= N(y),
+// applies the resulting matrix to x and y, and returns the reduced pair ordered by norm
+// ok is false if no step could be simulated or the larger norm did not decrease
+func lehmerReduce(x, y *GaussianInt) (nx, ny *GaussianInt, ok bool) {
+	shift := uint(x.BitLen() - lehmerBits)
+	opt := iPool.Get().(*big.Int)
+	defer iPool.Put(opt)
+	sx := [2]int64{opt.Rsh(x.R, shift).Int64(), opt.Rsh(x.I, shift).Int64()}
+	sy := [2]int64{opt.Rsh(y.R, shift).Int64(), opt.Rsh(y.I, shift).Int64()}
+	// the matrix [[m00, m01], [m10, m11]] of Gaussian integers maps (x, y) to the current pair (sx, sy)
+	m00, m01 := [2]int64{1, 0}, [2]int64{0, 0}
+	m10, m11 := [2]int64{0, 0}, [2]int64{1, 0}
+	steps := 0
+	for bitLen64(sy) > lehmerBits/2 {
+		// the quotient only needs to be close to the nearest Gaussian integer, so float64 is enough,
+		// and the remainder, cofactors, and products stay well below 2^63
+		quo := complex(float64(sx[0]), float64(sx[1])) / complex(float64(sy[0]), float64(sy[1]))
+		q := [2]int64{int64(math.Round(real(quo))), int64(math.Round(imag(quo)))}
+		rem := subMul64(sx, q, sy)
+		if norm64(rem) >= norm64(sy) {
+			break
+		}
+		sx, sy = sy, rem
+		m00, m10 = m10, subMul64(m00, q, m10)
+		m01, m11 = m11, subMul64(m01, q, m11)
+		steps++
+	}
+	if steps == 0 {
+		return nil, nil, false
+	}
+	nx = lehmerCombine(m00, x, m01, y)
+	ny = lehmerCombine(m10, x, m11, y)
+	if nx.CmpNorm(ny) < 0 {
+		nx, ny = ny, nx
+	}
+	if nx.CmpNorm(x) >= 0 {
+		return nil, nil, false
+	}
+	return nx, ny, true
+}
+
+// lehmerCombine returns c0 * x + c1 * y for the small Gaussian integers c0 and c1
+func lehmerCombine(c0 [2]int64, x *GaussianInt, c1 [2]int64, y *GaussianInt) *GaussianInt {
+	res := new(GaussianInt).Prod(NewGaussianInt(big.NewInt(c0[0]), big.NewInt(c0[1])), x)
+	opt := giPool.Get().(*GaussianInt).Prod(NewGaussianInt(big.NewInt(c1[0]), big.NewInt(c1[1])), y)
+	defer giPool.Put(opt)
+	return res.Add(res, opt)
+}
+
+// subMul64 returns a - q * b for Gaussian integers with int64 parts
+func subMul64(a, q, b [2]int64) [2]int64 {
+	return [2]int64{a[0] - q[0]*b[0] + q[1]*b[1], a[1] - q[0]*b[1] - q[1]*b[0]}
+}
+
+// norm64 returns the norm of a Gaussian integer with int64 parts as a float64
+func norm64(a [2]int64) float64 {
+	return float64(a[0])*float64(a[0]) + float64(a[1])*float64(a[1])
+}
+
+// bitLen64 returns the bit length of the larger-magnitude part of a Gaussian integer with int64 parts
+func bitLen64(a [2]int64) int {
+	r, i := a[0], a[1]
+	if r < 0 {
+		r = -r
+	}
+	if i < 0 {
+		i = -i
+	}
+	if i > r {
+		r = i
+	}
+	return bits.Len64(uint64(r))
+}
@@ -0,0 +1,235 @@
+// MIT License
+//
+// Copyright (c) 2022 Tommy TIAN
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package complex
+
+import (
+	"math/big"
+)
+
+// LipschitzInt implements Lipschitz quaternion (or Lipschitz integer) a + bi + cj + dk
+// The set of all Lipschitz quaternion is L = {a + bi + cj + dk | a, b, c, d are all integers}
+// It is the subring of the Hurwitz integers without half-integer scalars
+type LipschitzInt struct {
+	R *big.Int // real part
+	I *big.Int // i part
+	J *big.Int // j part
+	K *big.Int // k part
+}
+
+// String returns the string representation of the Lipschitz integer
+func (l *LipschitzInt) String() string {
+	return l.ToHurwitz().String()
+}
+
+// NewLipschitzInt declares a new Lipschitz integer with the real, i, j, and k parts
+func NewLipschitzInt(r, i, j, k *big.Int) *LipschitzInt {
+	return &LipschitzInt{
+		R: new(big.Int).Set(r),
+		I: new(big.Int).Set(i),
+		J: new(big.Int).Set(j),
+		K: new(big.Int).Set(k),
+	}
+}
+
+// Set sets the Lipschitz integer to the given Lipschitz integer
+func (l *LipschitzInt) Set(a *LipschitzInt) *LipschitzInt {
+	return l.Update(a.R, a.I, a.J, a.K)
+}
+
+// Update updates the Lipschitz integer with the given real, i, j, and k parts
+func (l *LipschitzInt) Update(r, i, j, k *big.Int) *LipschitzInt {
+	if l.R == nil {
+		l.R = new(big.Int)
+	}
+	l.R.Set(r)
+	if l.I == nil {
+		l.I = new(big.Int)
+	}
+	l.I.Set(i)
+	if l.J == nil {
+		l.J = new(big.Int)
+	}
+	l.J.Set(j)
+	if l.K == nil {
+		l.K = new(big.Int)
+	}
+	l.K.Set(k)
+	return l
+}
+
+// Add adds two Lipschitz integers
+func (l *LipschitzInt) Add(a, b *LipschitzInt) *LipschitzInt {
+	if l.R == nil {
+		l.R = new(big.Int)
+	}
+	l.R.Add(a.R, b.R)
+	if l.I == nil {
+		l.I = new(big.Int)
+	}
+	l.I.Add(a.I, b.I)
+	if l.J == nil {
+		l.J = new(big.Int)
+	}
+	l.J.Add(a.J, b.J)
+	if l.K == nil {
+		l.K = new(big.Int)
+	}
+	l.K.Add(a.K, b.K)
+	return l
+}
+
+// Sub subtracts two Lipschitz integers
+func (l *LipschitzInt) Sub(a, b *LipschitzInt) *LipschitzInt {
+	if l.R == nil {
+		l.R = new(big.Int)
+	}
+	l.R.Sub(a.R, b.R)
+	if l.I == nil {
+		l.I = new(big.Int)
+	}
+	l.I.Sub(a.I, b.I)
+	if l.J == nil {
+		l.J = new(big.Int)
+	}
+	l.J.Sub(a.J, b.J)
+	if l.K == nil {
+		l.K = new(big.Int)
+	}
+	l.K.Sub(a.K, b.K)
+	return l
+}
+
+// Conj obtains the conjugate of the original Lipschitz integer
+func (l *LipschitzInt) Conj(origin *LipschitzInt) *LipschitzInt {
+	if l.R == nil {
+		l.R = new(big.Int)
+	}
+	l.R.Set(origin.R)
+	if l.I == nil {
+		l.I = new(big.Int)
+	}
+	l.I.Neg(origin.I)
+	if l.J == nil {
+		l.J = new(big.Int)
+	}
+	l.J.Neg(origin.J)
+	if l.K == nil {
+		l.K = new(big.Int)
+	}
+	l.K.Neg(origin.K)
+	return l
+}
+
+// Norm obtains the norm of the Lipschitz integer
+func (l *LipschitzInt) Norm() *big.Int {
+	norm := new(big.Int).Mul(l.R, l.R)
+	opt := iPool.Get().(*big.Int).Mul(l.I, l.I)
+	defer iPool.Put(opt)
+	norm.Add(norm, opt)
+	opt.Mul(l.J, l.J)
+	norm.Add(norm, opt)
+	opt.Mul(l.K, l.K)
+	norm.Add(norm, opt)
+	return norm
+}
+
+// Prod returns the Hamilton product of two Lipschitz integers
+func (l *LipschitzInt) Prod(a, b *LipschitzInt) *LipschitzInt {
+	r, i, j, k := new(big.Int), new(big.Int), new(big.Int), new(big.Int)
+	opt := iPool.Get().(*big.Int)
+	defer iPool.Put(opt)
+	// 1 part
+	r.Mul(a.R, b.R)
+	r.Sub(r, opt.Mul(a.I, b.I))
+	r.Sub(r, opt.Mul(a.J, b.J))
+	r.Sub(r, opt.Mul(a.K, b.K))
+
+	// i part
+	i.Mul(a.R, b.I)
+	i.Add(i, opt.Mul(a.I, b.R))
+	i.Add(i, opt.Mul(a.J, b.K))
+	i.Sub(i, opt.Mul(a.K, b.J))
+
+	// j part
+	j.Mul(a.R, b.J)
+	j.Sub(j, opt.Mul(a.I, b.K))
+	j.Add(j, opt.Mul(a.J, b.R))
+	j.Add(j, opt.Mul(a.K, b.I))
+
+	// k part
+	k.Mul(a.R, b.K)
+	k.Add(k, opt.Mul(a.I, b.J))
+	k.Sub(k, opt.Mul(a.J, b.I))
+	k.Add(k, opt.Mul(a.K, b.R))
+
+	l.R, l.I, l.J, l.K = r, i, j, k
+	return l
+}
+
+// Equals checks if the two Lipschitz integers are equal
+func (l *LipschitzInt) Equals(a *LipschitzInt) bool {
+	return l.R.Cmp(a.R) == 0 &&
+		l.I.Cmp(a.I) == 0 &&
+		l.J.Cmp(a.J) == 0 &&
+		l.K.Cmp(a.K) == 0
+}
+
+// IsZero returns true if the Lipschitz integer is zero
+func (l *LipschitzInt) IsZero() bool {
+	return l.R.Sign() == 0 &&
+		l.I.Sign() == 0 &&
+		l.J.Sign() == 0 &&
+		l.K.Sign() == 0
+}
+
+// ToHurwitz converts the Lipschitz integer to a new Hurwitz integer
+func (l *LipschitzInt) ToHurwitz() *HurwitzInt {
+	return NewHurwitzInt(l.R, l.I, l.J, l.K, false)
+}
+
+// FromHurwitz sets the Lipschitz integer to the given Hurwitz integer
+// If the Hurwitz integer has half-integer scalars, it is not a Lipschitz integer,
+// the receiver is left unchanged and false is returned
+func (l *LipschitzInt) FromHurwitz(h *HurwitzInt) (*LipschitzInt, bool) {
+	if !h.IsLipschitz() {
+		return l, false
+	}
+	dblR, dblI, dblJ, dblK := h.doubled()
+	if l.R == nil {
+		l.R = new(big.Int)
+	}
+	l.R.Rsh(dblR, 1)
+	if l.I == nil {
+		l.I = new(big.Int)
+	}
+	l.I.Rsh(dblI, 1)
+	if l.J == nil {
+		l.J = new(big.Int)
+	}
+	l.J.Rsh(dblJ, 1)
+	if l.K == nil {
+		l.K = new(big.Int)
+	}
+	l.K.Rsh(dblK, 1)
+	return l, true
+}
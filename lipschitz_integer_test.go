@@ -0,0 +1,139 @@
+// MIT License
+//
+// Copyright (c) 2022 Tommy TIAN
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package complex
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestLipschitzInt_Prod(t *testing.T) {
+	type args struct {
+		a *LipschitzInt
+		b *LipschitzInt
+	}
+	tests := []struct {
+		name string
+		args args
+		want *LipschitzInt
+	}{
+		{
+			name: "test_(1+i+j+k) * (1+i+j+k)",
+			args: args{
+				a: NewLipschitzInt(big.NewInt(1), big.NewInt(1), big.NewInt(1), big.NewInt(1)),
+				b: NewLipschitzInt(big.NewInt(1), big.NewInt(1), big.NewInt(1), big.NewInt(1)),
+			},
+			want: NewLipschitzInt(big.NewInt(-2), big.NewInt(2), big.NewInt(2), big.NewInt(2)),
+		},
+		{
+			name: "test_2i * 1",
+			args: args{
+				a: NewLipschitzInt(big.NewInt(0), big.NewInt(2), big.NewInt(0), big.NewInt(0)),
+				b: NewLipschitzInt(big.NewInt(1), big.NewInt(0), big.NewInt(0), big.NewInt(0)),
+			},
+			want: NewLipschitzInt(big.NewInt(0), big.NewInt(2), big.NewInt(0), big.NewInt(0)),
+		},
+		{
+			name: "test_i * j",
+			args: args{
+				a: NewLipschitzInt(big.NewInt(0), big.NewInt(1), big.NewInt(0), big.NewInt(0)),
+				b: NewLipschitzInt(big.NewInt(0), big.NewInt(0), big.NewInt(1), big.NewInt(0)),
+			},
+			want: NewLipschitzInt(big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(1)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := new(LipschitzInt).Prod(tt.args.a, tt.args.b); !got.Equals(tt.want) {
+				t.Errorf("Prod() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLipschitzInt_Norm(t *testing.T) {
+	tests := []struct {
+		name string
+		l    *LipschitzInt
+		want *big.Int
+	}{
+		{
+			name: "test_1+i+j+k",
+			l:    NewLipschitzInt(big.NewInt(1), big.NewInt(1), big.NewInt(1), big.NewInt(1)),
+			want: big.NewInt(4),
+		},
+		{
+			name: "test_1-2i+3j-4k",
+			l:    NewLipschitzInt(big.NewInt(1), big.NewInt(-2), big.NewInt(3), big.NewInt(-4)),
+			want: big.NewInt(30),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.l.Norm(); got.Cmp(tt.want) != 0 {
+				t.Errorf("Norm() = %v, want %v", got, tt.want)
+			}
+			if got := tt.l.ToHurwitz().Norm(); got.Cmp(tt.want) != 0 {
+				t.Errorf("ToHurwitz().Norm() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLipschitzInt_FromHurwitz(t *testing.T) {
+	tests := []struct {
+		name   string
+		h      *HurwitzInt
+		want   *LipschitzInt
+		wantOK bool
+	}{
+		{
+			name:   "test_1-i+2j-3k",
+			h:      NewHurwitzInt(big.NewInt(1), big.NewInt(-1), big.NewInt(2), big.NewInt(-3), false),
+			want:   NewLipschitzInt(big.NewInt(1), big.NewInt(-1), big.NewInt(2), big.NewInt(-3)),
+			wantOK: true,
+		},
+		{
+			name:   "test_0.5+0.5i+0.5j-0.5k",
+			h:      NewHurwitzInt(big.NewInt(1), big.NewInt(1), big.NewInt(1), big.NewInt(-1), true),
+			wantOK: false,
+		},
+		{
+			name:   "test_zero_value",
+			h:      new(HurwitzInt),
+			want:   NewLipschitzInt(big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0)),
+			wantOK: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := new(LipschitzInt).FromHurwitz(tt.h)
+			if ok != tt.wantOK {
+				t.Fatalf("FromHurwitz() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if ok && !got.Equals(tt.want) {
+				t.Errorf("FromHurwitz() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,210 @@
+// MIT License
+//
+// Copyright (c) 2022 Tommy TIAN
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package complex
+
+import "math/big"
+
+// Cornacchia solves the equation x^2 + d * y^2 = n with Cornacchia's algorithm
+// The modulus n must be a prime and d must satisfy 0 < d < n, otherwise the result is meaningless
+// If the equation has no solution, ok is false
+// For d = 1 the solution is the representation of the prime n as a sum of two squares
+func Cornacchia(d, n *big.Int) (x, y *big.Int, ok bool) {
+	if d.Sign() <= 0 || d.Cmp(n) >= 0 {
+		return nil, nil, false
+	}
+	if n.Cmp(big2) == 0 {
+		// 1^2 + 1 * 1^2 = 2 is the only solution
+		return big.NewInt(1), big.NewInt(1), true
+	}
+	if n.Bit(0) == 0 {
+		return nil, nil, false
+	}
+	// find r0 such that r0^2 = -d (mod n)
+	negD := new(big.Int).Sub(n, d)
+	r0 := new(big.Int).ModSqrt(negD, n)
+	if r0 == nil {
+		return nil, nil, false
+	}
+	half := iPool.Get().(*big.Int).Rsh(n, 1)
+	defer iPool.Put(half)
+	if r0.Cmp(half) <= 0 {
+		r0.Sub(n, r0)
+	}
+	// run the Euclidean algorithm on n and r0 until the remainder is smaller than sqrt(n)
+	a := new(big.Int).Set(n)
+	b := r0
+	opt := iPool.Get().(*big.Int)
+	defer iPool.Put(opt)
+	for opt.Mul(b, b).Cmp(n) >= 0 {
+		a.Mod(a, b)
+		a, b = b, a
+	}
+	// check whether (n - b^2) / d is a perfect square
+	rest := new(big.Int).Sub(n, opt.Mul(b, b))
+	if new(big.Int).Mod(rest, d).Sign() != 0 {
+		return nil, nil, false
+	}
+	rest.Quo(rest, d)
+	s := new(big.Int).Sqrt(rest)
+	if opt.Mul(s, s).Cmp(rest) != 0 {
+		return nil, nil, false
+	}
+	return b, s, true
+}
+
+// SqrtMinusOneModP returns the smaller square root x of -1 modulo the prime p, i.e. x^2 = -1 (mod p) with 0 < x < p/2,
+// computed by the Tonelli-Shanks algorithm of big.Int.ModSqrt
+// The only exception is p = 2, where 1 is the single root and the result is x = 1 = p/2
+// A root exists only for p = 2 and primes p = 1 (mod 4); ok is false otherwise, including when p is not a prime
+func SqrtMinusOneModP(p *big.Int) (x *big.Int, ok bool) {
+	if p.Cmp(big2) == 0 {
+		return big.NewInt(1), true
+	}
+	if p.Sign() <= 0 || p.Bit(0) == 0 || p.Bit(1) == 1 || !p.ProbablyPrime(primalityRounds) {
+		return nil, false
+	}
+	x = new(big.Int).ModSqrt(new(big.Int).Sub(p, big1), p)
+	if half := new(big.Int).Rsh(p, 1); x.Cmp(half) > 0 {
+		x.Sub(p, x)
+	}
+	return x, true
+}
+
+// CountFourSquareRepresentations returns r4(n), the number of ways to represent n as a sum of four squares,
+// counting signs and orders, using Jacobi's four-square theorem:
+// r4(n) = 8 * sigma(n) if n is odd, and r4(n) = 24 * sigma(m) if n = 2^k * m with k > 0 and m odd
+// sigma is computed from the factorization of n by trial division,
+// so it is only practical for n without two or more large prime factors
+func CountFourSquareRepresentations(n *big.Int) *big.Int {
+	switch n.Sign() {
+	case -1:
+		return big.NewInt(0)
+	case 0:
+		return big.NewInt(1)
+	}
+	k := n.TrailingZeroBits()
+	m := new(big.Int).Rsh(n, k)
+	res := divisorSum(m)
+	if k == 0 {
+		return res.Mul(res, big.NewInt(8))
+	}
+	return res.Mul(res, big.NewInt(24))
+}
+
+// FourSquaresMinimal returns a representation n = w^2 + x^2 + y^2 + z^2 as a sum of four squares
+// whose largest term is as small as possible, i.e. the most balanced one
+// The result is ordered as w >= x >= y >= z >= 0, and among the representations with the smallest w
+// the one with the smallest x, and then the smallest y, is chosen, so the result is unique
+// Every non-negative integer is a sum of four squares (Lagrange's four-square theorem); it scans w upward
+// from ceil(sqrt(n/4)), and for each w the values of x and y in the narrow ranges allowed by the ordering,
+// so it is only practical for n of moderate size
+// For negative n the results are all nil
+func FourSquaresMinimal(n *big.Int) (w, x, y, z *big.Int) {
+	if n.Sign() < 0 {
+		return nil, nil, nil, nil
+	}
+	w, x, y, z = new(big.Int), new(big.Int), new(big.Int), new(big.Int)
+	rest, rest2, rest3, bound := new(big.Int), new(big.Int), new(big.Int), new(big.Int)
+	for w.Set(ceilSqrt(bound.Rsh(bound.Add(n, big.NewInt(3)), 2))); ; w.Add(w, big1) {
+		rest.Sub(n, rest.Mul(w, w))
+		// x is at most w and at least sqrt(rest / 3), since x >= y >= z
+		xMax := new(big.Int).Sqrt(rest)
+		if xMax.Cmp(w) > 0 {
+			xMax.Set(w)
+		}
+		x.Quo(bound.Add(rest, big2), big.NewInt(3))
+		for x.Set(ceilSqrt(x)); x.Cmp(xMax) <= 0; x.Add(x, big1) {
+			rest2.Sub(rest, rest2.Mul(x, x))
+			// y is at most x and at least sqrt(rest2 / 2), since y >= z
+			yMax := new(big.Int).Sqrt(rest2)
+			if yMax.Cmp(x) > 0 {
+				yMax.Set(x)
+			}
+			for y.Set(ceilSqrt(bound.Rsh(bound.Add(rest2, big1), 1))); y.Cmp(yMax) <= 0; y.Add(y, big1) {
+				rest3.Sub(rest2, rest3.Mul(y, y))
+				z.Sqrt(rest3)
+				if bound.Mul(z, z).Cmp(rest3) == 0 {
+					return w, x, y, z
+				}
+			}
+		}
+	}
+}
+
+// ceilSqrt returns the smallest integer whose square is not less than the non-negative integer n
+func ceilSqrt(n *big.Int) *big.Int {
+	root := new(big.Int).Sqrt(n)
+	if new(big.Int).Mul(root, root).Cmp(n) < 0 {
+		root.Add(root, big1)
+	}
+	return root
+}
+
+// divisorSum returns the sum of all the positive divisors of the positive integer n
+func divisorSum(n *big.Int) *big.Int {
+	res := big.NewInt(1)
+	primes, exps := trialFactor(n)
+	for idx, p := range primes {
+		// the sum of the divisors of p^e is 1 + p + ... + p^e
+		pPow := big.NewInt(1)
+		factorSum := big.NewInt(1)
+		for e := 0; e < exps[idx]; e++ {
+			pPow.Mul(pPow, p)
+			factorSum.Add(factorSum, pPow)
+		}
+		res.Mul(res, factorSum)
+	}
+	return res
+}
+
+// trialFactor returns the prime factors of the positive integer n in ascending order
+// together with their exponents using trial division
+func trialFactor(n *big.Int) (primes []*big.Int, exps []int) {
+	rest := new(big.Int).Set(n)
+	p := big.NewInt(2)
+	quo, mod := new(big.Int), new(big.Int)
+	opt := iPool.Get().(*big.Int)
+	defer iPool.Put(opt)
+	for opt.Mul(p, p).Cmp(rest) <= 0 {
+		e := 0
+		for {
+			quo.QuoRem(rest, p, mod)
+			if mod.Sign() != 0 {
+				break
+			}
+			rest.Set(quo)
+			e++
+		}
+		if e > 0 {
+			primes = append(primes, new(big.Int).Set(p))
+			exps = append(exps, e)
+		}
+		p.Add(p, big1)
+	}
+	if rest.Cmp(big1) > 0 {
+		// the remaining factor is a prime
+		primes = append(primes, rest)
+		exps = append(exps, 1)
+	}
+	return primes, exps
+}
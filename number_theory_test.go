@@ -0,0 +1,262 @@
+// MIT License
+//
+// Copyright (c) 2022 Tommy TIAN
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package complex
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestCornacchia(t *testing.T) {
+	tests := []struct {
+		name   string
+		d      *big.Int
+		n      *big.Int
+		wantOK bool
+	}{
+		{
+			name:   "test_d=1_n=13",
+			d:      big.NewInt(1),
+			n:      big.NewInt(13),
+			wantOK: true,
+		},
+		{
+			name:   "test_d=1_n=2",
+			d:      big.NewInt(1),
+			n:      big.NewInt(2),
+			wantOK: true,
+		},
+		{
+			name:   "test_d=1_n=7",
+			d:      big.NewInt(1),
+			n:      big.NewInt(7),
+			wantOK: false,
+		},
+		{
+			name:   "test_d=3_n=31",
+			d:      big.NewInt(3),
+			n:      big.NewInt(31),
+			wantOK: true,
+		},
+		{
+			name:   "test_d=2_n=1000003",
+			d:      big.NewInt(2),
+			n:      big.NewInt(1000003),
+			wantOK: true,
+		},
+		{
+			name:   "test_d=0_n=13",
+			d:      big.NewInt(0),
+			n:      big.NewInt(13),
+			wantOK: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			x, y, ok := Cornacchia(tt.d, tt.n)
+			if ok != tt.wantOK {
+				t.Fatalf("Cornacchia() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if !ok {
+				return
+			}
+			got := new(big.Int).Mul(y, y)
+			got.Mul(got, tt.d)
+			got.Add(got, new(big.Int).Mul(x, x))
+			if got.Cmp(tt.n) != 0 {
+				t.Errorf("Cornacchia() = (%v, %v), x^2 + d * y^2 = %v, want %v", x, y, got, tt.n)
+			}
+		})
+	}
+	x, y, _ := Cornacchia(big.NewInt(1), big.NewInt(13))
+	if !(x.Cmp(big.NewInt(3)) == 0 && y.Cmp(big.NewInt(2)) == 0) &&
+		!(x.Cmp(big.NewInt(2)) == 0 && y.Cmp(big.NewInt(3)) == 0) {
+		t.Errorf("Cornacchia(1, 13) = (%v, %v), want (3, 2)", x, y)
+	}
+}
+
+func TestSqrtMinusOneModP(t *testing.T) {
+	tests := []struct {
+		name   string
+		p      *big.Int
+		want   *big.Int
+		wantOK bool
+	}{
+		{"test_p=13", big.NewInt(13), big.NewInt(5), true},
+		{"test_p=2", big.NewInt(2), big.NewInt(1), true},
+		{"test_p=5", big.NewInt(5), big.NewInt(2), true},
+		{"test_p=17", big.NewInt(17), big.NewInt(4), true},
+		{"test_p=7", big.NewInt(7), nil, false},
+		{"test_p=21", big.NewInt(21), nil, false},
+		{"test_p=25", big.NewInt(25), nil, false},
+		{"test_p=1", big.NewInt(1), nil, false},
+		{"test_p=-13", big.NewInt(-13), nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := SqrtMinusOneModP(tt.p)
+			if ok != tt.wantOK {
+				t.Fatalf("SqrtMinusOneModP() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if !ok {
+				return
+			}
+			if got.Cmp(tt.want) != 0 {
+				t.Errorf("SqrtMinusOneModP() = %v, want %v", got, tt.want)
+			}
+			square := new(big.Int).Mul(got, got)
+			if square.Add(square, big1).Mod(square, tt.p).Sign() != 0 {
+				t.Errorf("SqrtMinusOneModP() = %v, square is not -1 mod %v", got, tt.p)
+			}
+		})
+	}
+}
+
+func TestCountFourSquareRepresentations(t *testing.T) {
+	tests := []struct {
+		name string
+		n    *big.Int
+		want *big.Int
+	}{
+		{
+			name: "test_0",
+			n:    big.NewInt(0),
+			want: big.NewInt(1),
+		},
+		{
+			name: "test_1",
+			n:    big.NewInt(1),
+			want: big.NewInt(8),
+		},
+		{
+			name: "test_2",
+			n:    big.NewInt(2),
+			want: big.NewInt(24),
+		},
+		{
+			name: "test_4",
+			n:    big.NewInt(4),
+			want: big.NewInt(24),
+		},
+		{
+			name: "test_12",
+			n:    big.NewInt(12),
+			want: big.NewInt(96),
+		},
+		{
+			name: "test_25",
+			n:    big.NewInt(25),
+			want: big.NewInt(248),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CountFourSquareRepresentations(tt.n); got.Cmp(tt.want) != 0 {
+				t.Errorf("CountFourSquareRepresentations() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFourSquaresMinimal(t *testing.T) {
+	for n := int64(0); n <= 500; n++ {
+		// the lexicographically smallest (w, x, y) by brute force
+		wantMax, wantX, wantY := int64(-1), int64(-1), int64(-1)
+		for w := int64(0); w*w <= n && wantMax < 0; w++ {
+			for x := int64(0); x <= w && wantMax < 0; x++ {
+				for y := int64(0); y <= x && wantMax < 0; y++ {
+					rest := n - w*w - x*x - y*y
+					z := int64(math.Sqrt(float64(rest)))
+					if rest >= 0 && z <= y && z*z == rest {
+						wantMax, wantX, wantY = w, x, y
+					}
+				}
+			}
+		}
+		w, x, y, z := FourSquaresMinimal(big.NewInt(n))
+		sum := new(big.Int).Mul(w, w)
+		for _, v := range []*big.Int{x, y, z} {
+			sum.Add(sum, new(big.Int).Mul(v, v))
+		}
+		if sum.Int64() != n {
+			t.Fatalf("FourSquaresMinimal(%d) = %v, %v, %v, %v, squares sum to %v", n, w, x, y, z, sum)
+		}
+		if w.Cmp(x) < 0 || x.Cmp(y) < 0 || y.Cmp(z) < 0 || z.Sign() < 0 {
+			t.Fatalf("FourSquaresMinimal(%d) = %v, %v, %v, %v, not in descending order", n, w, x, y, z)
+		}
+		if w.Int64() != wantMax {
+			t.Fatalf("FourSquaresMinimal(%d) largest term = %v, want %v", n, w, wantMax)
+		}
+		if x.Int64() != wantX || y.Int64() != wantY {
+			t.Fatalf("FourSquaresMinimal(%d) = %v, %v, %v, %v, want x = %d and y = %d", n, w, x, y, z, wantX, wantY)
+		}
+	}
+	// 4 * k^2 is perfectly balanced as k^2 + k^2 + k^2 + k^2
+	k := new(big.Int).Lsh(big1, 40)
+	n := new(big.Int).Lsh(new(big.Int).Mul(k, k), 2)
+	w, x, y, z := FourSquaresMinimal(n)
+	for _, v := range []*big.Int{w, x, y, z} {
+		if v.Cmp(k) != 0 {
+			t.Fatalf("FourSquaresMinimal(%v) = %v, %v, %v, %v, want %v four times", n, w, x, y, z, k)
+		}
+	}
+	// 999999 = 7 (mod 8) needs four non-zero squares
+	w, x, y, z = FourSquaresMinimal(big.NewInt(999999))
+	sum := new(big.Int).Mul(w, w)
+	for _, v := range []*big.Int{x, y, z} {
+		sum.Add(sum, new(big.Int).Mul(v, v))
+	}
+	if sum.Int64() != 999999 || z.Sign() == 0 || w.Int64() < 500 {
+		t.Errorf("FourSquaresMinimal(999999) = %v, %v, %v, %v", w, x, y, z)
+	}
+	if w, _, _, _ := FourSquaresMinimal(big.NewInt(-1)); w != nil {
+		t.Errorf("FourSquaresMinimal(-1) = %v, want nil", w)
+	}
+}
+
+func TestFourSquaresMinimal_TieBreak(t *testing.T) {
+	tests := []struct {
+		name string
+		n    int64
+		want [4]int64
+	}{
+		// 18 = 3^2 + 3^2 + 0^2 + 0^2 has the same largest term, but a larger x
+		{"test_18_smallest_x", 18, [4]int64{3, 2, 2, 1}},
+		// 77 is also 6^2 + 5^2 + 4^2 + 0^2 and 6^2 + 6^2 + 2^2 + 1^2
+		{"test_77_smallest_x", 77, [4]int64{6, 4, 4, 3}},
+		// 75 = 5^2 + 5^2 + 5^2 + 0^2 has the same w and x, but a larger y
+		{"test_75_smallest_y", 75, [4]int64{5, 5, 4, 3}},
+		// 86 is also 6^2 + 5^2 + 5^2 + 0^2
+		{"test_86_smallest_y", 86, [4]int64{6, 5, 4, 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w, x, y, z := FourSquaresMinimal(big.NewInt(tt.n))
+			got := [4]int64{w.Int64(), x.Int64(), y.Int64(), z.Int64()}
+			if got != tt.want {
+				t.Errorf("FourSquaresMinimal(%d) = %v, want %v", tt.n, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+// MIT License
+//
+// Copyright (c) 2022 Tommy TIAN
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package complex
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+)
+
+// ErrInvalidSyntax is returned when parsing a string that does not follow the expected grammar
+var ErrInvalidSyntax = errors.New("invalid syntax")
+
+// ParseGaussianIntCanonical parses a Gaussian integer in the canonical form produced by StringCanonical,
+// i.e. an optionally negative decimal real part, a '+' or '-' sign, an unsigned decimal imaginary part, and 'i'
+func ParseGaussianIntCanonical(s string) (*GaussianInt, error) {
+	if !strings.HasSuffix(s, "i") {
+		return nil, ErrInvalidSyntax
+	}
+	s = s[:len(s)-1]
+	// the sign of the real part, if any, is the first byte, so the separator is searched after it
+	sep := strings.LastIndexAny(s, "+-")
+	if sep <= 0 || !isDecimal(strings.TrimPrefix(s[:sep], "-")) || !isDecimal(s[sep+1:]) {
+		return nil, ErrInvalidSyntax
+	}
+	r, _ := new(big.Int).SetString(s[:sep], 10)
+	i, _ := new(big.Int).SetString(s[sep:], 10)
+	return &GaussianInt{R: r, I: i}, nil
+}
+
+// ErrMixedParity is returned when parsing a quaternion that mixes integer and half-integer components,
+// which is not a Hurwitz integer
+var ErrMixedParity = errors.New("mixed integer and half-integer components")
+
+// ParseHurwitzInt parses a Hurwitz integer in the form produced by String, e.g. 1.5-0.5i+2.5j-k or 0
+// The terms are the real part and the i, j, and k parts in this order, each optional but not repeated,
+// with a sign ('-' or, except for the first term, '+'), a decimal integer or half-integer (with the .5 suffix)
+// coefficient that may be omitted for i, j, and k to mean 1, and the unit
+// ErrMixedParity is returned if both integer and half-integer components are present
+func ParseHurwitzInt(s string) (*HurwitzInt, error) {
+	if s == "" {
+		return nil, ErrInvalidSyntax
+	}
+	var dbl [4]*big.Int
+	next := 0
+	for pos := 0; pos < len(s); {
+		neg := false
+		switch {
+		case s[pos] == '-':
+			neg = true
+			pos++
+		case s[pos] == '+' && pos > 0:
+			pos++
+		case pos > 0:
+			return nil, ErrInvalidSyntax
+		}
+		end := pos
+		for end < len(s) && s[end] != '+' && s[end] != '-' {
+			end++
+		}
+		term := s[pos:end]
+		pos = end
+		if term == "" {
+			return nil, ErrInvalidSyntax
+		}
+		// idx is the index of the component, 0 for the real part and 1 to 3 for i, j, and k
+		idx := strings.IndexByte("ijk", term[len(term)-1]) + 1
+		if idx > 0 {
+			term = term[:len(term)-1]
+		}
+		if idx < next {
+			return nil, ErrInvalidSyntax
+		}
+		next = idx + 1
+		half := strings.HasSuffix(term, ".5")
+		if half {
+			term = term[:len(term)-2]
+		}
+		x := new(big.Int)
+		switch {
+		case isDecimal(term):
+			x.SetString(term, 10)
+		case term == "" && idx > 0 && !half:
+			x.SetInt64(1)
+		default:
+			return nil, ErrInvalidSyntax
+		}
+		x.Lsh(x, 1)
+		if half {
+			x.Add(x, big1)
+		}
+		if neg {
+			x.Neg(x)
+		}
+		dbl[idx] = x
+	}
+	for idx := range dbl {
+		if dbl[idx] == nil {
+			dbl[idx] = new(big.Int)
+		}
+		if dbl[idx].Bit(0) != dbl[0].Bit(0) {
+			return nil, ErrMixedParity
+		}
+	}
+	return NewHurwitzInt(dbl[0], dbl[1], dbl[2], dbl[3], true), nil
+}
+
+// isDecimal reports whether the string is a non-empty sequence of decimal digits
+func isDecimal(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,174 @@
+// MIT License
+//
+// Copyright (c) 2022 Tommy TIAN
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package complex
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func TestGaussianInt_StringCanonical(t *testing.T) {
+	tests := []struct {
+		name string
+		g    *GaussianInt
+		want string
+	}{
+		{"test_zero", NewGaussianInt(big.NewInt(0), big.NewInt(0)), "0+0i"},
+		{"test_imag_unit", NewGaussianInt(big.NewInt(0), big.NewInt(1)), "0+1i"},
+		{"test_real_only", NewGaussianInt(big.NewInt(3), big.NewInt(0)), "3+0i"},
+		{"test_negative_imag", NewGaussianInt(big.NewInt(-3), big.NewInt(-1)), "-3-1i"},
+		{"test_both", NewGaussianInt(big.NewInt(5), big.NewInt(12)), "5+12i"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.g.StringCanonical(); got != tt.want {
+				t.Errorf("StringCanonical() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseGaussianIntCanonical(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    *GaussianInt
+		wantErr bool
+	}{
+		{"test_zero", "0+0i", NewGaussianInt(big.NewInt(0), big.NewInt(0)), false},
+		{"test_negative", "-3-4i", NewGaussianInt(big.NewInt(-3), big.NewInt(-4)), false},
+		{"test_positive", "12+5i", NewGaussianInt(big.NewInt(12), big.NewInt(5)), false},
+		{"test_pretty_form", "3+i", nil, true},
+		{"test_missing_real", "-4i", nil, true},
+		{"test_missing_imag", "3", nil, true},
+		{"test_double_sign", "3+-4i", nil, true},
+		{"test_plus_real", "+3+4i", nil, true},
+		{"test_spaces", "3 + 4i", nil, true},
+		{"test_empty", "", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseGaussianIntCanonical(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseGaussianIntCanonical() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !got.Equals(tt.want) {
+				t.Errorf("ParseGaussianIntCanonical() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseGaussianIntCanonical_RoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	bound := new(big.Int).Lsh(big1, 300)
+	for n := 0; n < 1000; n++ {
+		g := NewGaussianInt(new(big.Int).Rand(r, bound), new(big.Int).Rand(r, bound))
+		if r.Intn(2) == 0 {
+			g.R.Neg(g.R)
+		}
+		if r.Intn(2) == 0 {
+			g.I.Neg(g.I)
+		}
+		if r.Intn(4) == 0 {
+			g.I.SetInt64(0)
+		}
+		got, err := ParseGaussianIntCanonical(g.StringCanonical())
+		if err != nil || !got.Equals(g) {
+			t.Fatalf("ParseGaussianIntCanonical(%v) = %v, %v", g.StringCanonical(), got, err)
+		}
+	}
+}
+
+func TestParseHurwitzInt(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    *HurwitzInt
+		wantErr error
+	}{
+		{"test_zero", "0", HurwitzZero(), nil},
+		{"test_units", "1+i+j+k", NewHurwitzInt(big.NewInt(1), big.NewInt(1), big.NewInt(1), big.NewInt(1), false), nil},
+		{"test_negative_units", "-i-j-k", NewHurwitzInt(big.NewInt(0), big.NewInt(-1), big.NewInt(-1), big.NewInt(-1), false), nil},
+		{"test_halves", "-0.5i-0.5j+0.5k+0.5", nil, ErrInvalidSyntax},
+		{"test_halves_in_order", "0.5-0.5i-0.5j+0.5k", NewHurwitzInt(big.NewInt(1), big.NewInt(-1), big.NewInt(-1), big.NewInt(1), true), nil},
+		{"test_large_half", "0.5+0.5i+0.5j-61728.5k", NewHurwitzInt(big.NewInt(1), big.NewInt(1), big.NewInt(1), big.NewInt(-123457), true), nil},
+		{"test_skipped_parts", "-4+7k", NewHurwitzInt(big.NewInt(-4), big.NewInt(0), big.NewInt(0), big.NewInt(7), false), nil},
+		{"test_mixed_parity", "1+0.5i", nil, ErrMixedParity},
+		{"test_half_without_all_parts", "0.5i", nil, ErrMixedParity},
+		{"test_repeated_part", "1+i+2i", nil, ErrInvalidSyntax},
+		{"test_wrong_order", "j+i", nil, ErrInvalidSyntax},
+		{"test_leading_plus", "+1", nil, ErrInvalidSyntax},
+		{"test_double_sign", "1+-i", nil, ErrInvalidSyntax},
+		{"test_trailing_sign", "1+", nil, ErrInvalidSyntax},
+		{"test_bad_fraction", "1.25+i", nil, ErrInvalidSyntax},
+		{"test_bare_half", ".5+.5i+.5j+.5k", nil, ErrInvalidSyntax},
+		{"test_bad_unit", "1+2l", nil, ErrInvalidSyntax},
+		{"test_empty", "", nil, ErrInvalidSyntax},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseHurwitzInt(tt.s)
+			if err != tt.wantErr {
+				t.Fatalf("ParseHurwitzInt() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && !got.Equals(tt.want) {
+				t.Errorf("ParseHurwitzInt() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseHurwitzInt_RoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	bound := new(big.Int).Lsh(big1, 300)
+	for n := 0; n < 1000; n++ {
+		var dbl [4]*big.Int
+		half := r.Intn(2)
+		for idx := range dbl {
+			dbl[idx] = new(big.Int)
+			switch r.Intn(4) {
+			case 0:
+				// keep the component zero or a unit coefficient
+				dbl[idx].SetInt64(int64(r.Intn(3)-1) * 2)
+			default:
+				dbl[idx].Rand(r, bound)
+				if r.Intn(2) == 0 {
+					dbl[idx].Neg(dbl[idx])
+				}
+			}
+			dbl[idx].SetBit(dbl[idx], 0, uint(half))
+		}
+		h := NewHurwitzInt(dbl[0], dbl[1], dbl[2], dbl[3], true)
+		got, err := ParseHurwitzInt(h.String())
+		if err != nil || !got.Equals(h) {
+			t.Fatalf("ParseHurwitzInt(%v) = %v, %v", h.String(), got, err)
+		}
+	}
+	for _, u := range HurwitzUnits() {
+		if got, err := ParseHurwitzInt(u.String()); err != nil || !got.Equals(u) {
+			t.Fatalf("ParseHurwitzInt(%v) = %v, %v", u.String(), got, err)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+// MIT License
+//
+// Copyright (c) 2022 Tommy TIAN
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package complex
+
+// EvalGaussian evaluates the polynomial with the given coefficients at x using Horner's method
+// coeffs[k] is the coefficient of x^k, and the polynomial with no coefficients evaluates to zero
+func EvalGaussian(coeffs []*GaussianInt, x *GaussianInt) *GaussianInt {
+	res := NewGaussianInt(big0, big0)
+	if len(coeffs) == 0 {
+		return res
+	}
+	xc := giPool.Get().(*GaussianInt).Set(x)
+	defer giPool.Put(xc)
+	res.Set(coeffs[len(coeffs)-1])
+	for idx := len(coeffs) - 2; idx >= 0; idx-- {
+		res.Prod(res, xc)
+		res.Add(res, coeffs[idx])
+	}
+	return res
+}
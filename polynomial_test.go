@@ -0,0 +1,79 @@
+// MIT License
+//
+// Copyright (c) 2022 Tommy TIAN
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package complex
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestEvalGaussian(t *testing.T) {
+	type args struct {
+		coeffs []*GaussianInt
+		x      *GaussianInt
+	}
+	tests := []struct {
+		name string
+		args args
+		want *GaussianInt
+	}{
+		{
+			name: "test_empty",
+			args: args{
+				coeffs: nil,
+				x:      NewGaussianInt(big.NewInt(3), big.NewInt(4)),
+			},
+			want: NewGaussianInt(big.NewInt(0), big.NewInt(0)),
+		},
+		{
+			name: "test_x^2+1_at_i",
+			args: args{
+				coeffs: []*GaussianInt{
+					NewGaussianInt(big.NewInt(1), big.NewInt(0)),
+					NewGaussianInt(big.NewInt(0), big.NewInt(0)),
+					NewGaussianInt(big.NewInt(1), big.NewInt(0)),
+				},
+				x: NewGaussianInt(big.NewInt(0), big.NewInt(1)),
+			},
+			want: NewGaussianInt(big.NewInt(0), big.NewInt(0)),
+		},
+		{
+			name: "test_ix+(2-i)_at_1+i",
+			args: args{
+				coeffs: []*GaussianInt{
+					NewGaussianInt(big.NewInt(2), big.NewInt(-1)),
+					NewGaussianInt(big.NewInt(0), big.NewInt(1)),
+				},
+				x: NewGaussianInt(big.NewInt(1), big.NewInt(1)),
+			},
+			want: NewGaussianInt(big.NewInt(1), big.NewInt(0)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EvalGaussian(tt.args.coeffs, tt.args.x); !got.Equals(tt.want) {
+				t.Errorf("EvalGaussian() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -27,13 +27,12 @@ import (
 	"sync"
 )
 
+// Pools of temporaries for intermediate results. A pooled value is owned by the function that got it until
+// it is put back, so it must neither escape to the caller nor share its big numbers with other values
 var (
 	iPool = sync.Pool{
 		New: func() interface{} { return new(big.Int) },
 	}
-	fPool = sync.Pool{
-		New: func() interface{} { return new(big.Float) },
-	}
 	giPool = sync.Pool{
 		New: func() interface{} { return new(GaussianInt) },
 	}
@@ -41,3 +40,42 @@ var (
 		New: func() interface{} { return new(HurwitzInt) },
 	}
 )
+
+// Pools of the values handed out by AcquireGaussianInt and AcquireHurwitzInt, kept apart from the pools of
+// temporaries, so that a value used by the caller after Release cannot corrupt the intermediate results of the package
+var (
+	acquiredGIPool = sync.Pool{
+		New: func() interface{} { return new(GaussianInt) },
+	}
+	acquiredHIPool = sync.Pool{
+		New: func() interface{} { return new(HurwitzInt) },
+	}
+)
+
+// AcquireGaussianInt returns a Gaussian integer equal to zero from a pool,
+// so that hot loops can recycle Gaussian integers instead of allocating new ones
+// Pass it to Release once it is no longer needed; neither it nor its parts R and I may be used or retained
+// after Release, and big integers shared with other values must be detached before releasing
+func AcquireGaussianInt() *GaussianInt {
+	return acquiredGIPool.Get().(*GaussianInt).Reset()
+}
+
+// Release puts a Gaussian integer obtained from AcquireGaussianInt back into its pool
+// The Gaussian integer must not be used after Release
+func (g *GaussianInt) Release() {
+	acquiredGIPool.Put(g)
+}
+
+// AcquireHurwitzInt returns a Hurwitz integer equal to zero from a pool,
+// with the same lifecycle as AcquireGaussianInt
+// Prod, AddMul and the other methods storing their results in the existing big integers of the receiver
+// reuse it without allocating
+func AcquireHurwitzInt() *HurwitzInt {
+	return acquiredHIPool.Get().(*HurwitzInt).Reset()
+}
+
+// Release puts a Hurwitz integer obtained from AcquireHurwitzInt back into its pool
+// The Hurwitz integer must not be used after Release
+func (h *HurwitzInt) Release() {
+	acquiredHIPool.Put(h)
+}
@@ -0,0 +1,56 @@
+// MIT License
+//
+// Copyright (c) 2022 Tommy TIAN
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package complex
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestAcquireGaussianInt(t *testing.T) {
+	for n := 0; n < 10; n++ {
+		g := AcquireGaussianInt()
+		if g.R == nil || g.I == nil || !g.IsZero() {
+			t.Fatalf("AcquireGaussianInt() = %v, want 0", g)
+		}
+		g.Prod(NewGaussianInt(big.NewInt(3), big.NewInt(4)), NewGaussianInt(big.NewInt(1), big.NewInt(-2)))
+		if !g.Equals(NewGaussianInt(big.NewInt(11), big.NewInt(-2))) {
+			t.Fatalf("Prod() = %v, want 11-2i", g)
+		}
+		g.Release()
+	}
+}
+
+func TestAcquireHurwitzInt(t *testing.T) {
+	for n := 0; n < 10; n++ {
+		h := AcquireHurwitzInt()
+		if h.dblR == nil || h.dblK == nil || !h.IsZero() {
+			t.Fatalf("AcquireHurwitzInt() = %v, want 0", h)
+		}
+		h.Prod(HurwitzI(), HurwitzJ())
+		if !h.Equals(HurwitzK()) {
+			t.Fatalf("Prod() = %v, want k", h)
+		}
+		h.Release()
+	}
+}
@@ -0,0 +1,65 @@
+// MIT License
+//
+// Copyright (c) 2022 Tommy TIAN
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package complex
+
+import "math/big"
+
+// DigitsBaseIminus1 returns the binary expansion of the Gaussian integer in base (i-1)
+// Every Gaussian integer has a unique expansion whose digits are all 0 or 1
+// The digits are ordered from the least significant to the most significant,
+// and the expansion of zero is empty
+func (g *GaussianInt) DigitsBaseIminus1() []int {
+	var digits []int
+	cur := giPool.Get().(*GaussianInt).Set(g)
+	defer giPool.Put(cur)
+	// dividing by (i-1) equals multiplying by (-1-i) and halving
+	factor := giPool.Get().(*GaussianInt).Update(bigNeg1, bigNeg1)
+	defer giPool.Put(factor)
+	for !cur.IsZero() {
+		// (i-1) divides a+bi if and only if a+b is even
+		digit := int(cur.R.Bit(0) ^ cur.I.Bit(0))
+		digits = append(digits, digit)
+		if digit == 1 {
+			cur.R.Sub(cur.R, big1)
+		}
+		cur.Prod(cur, factor)
+		cur.R.Rsh(cur.R, 1)
+		cur.I.Rsh(cur.I, 1)
+	}
+	return digits
+}
+
+// FromDigitsBaseIminus1 returns the Gaussian integer with the given expansion in base (i-1)
+// The digits are ordered from the least significant to the most significant and should be 0 or 1
+func FromDigitsBaseIminus1(digits []int) *GaussianInt {
+	res := NewGaussianInt(big0, big0)
+	base := giPool.Get().(*GaussianInt).Update(bigNeg1, big1)
+	defer giPool.Put(base)
+	opt := iPool.Get().(*big.Int)
+	defer iPool.Put(opt)
+	for idx := len(digits) - 1; idx >= 0; idx-- {
+		res.Prod(res, base)
+		res.R.Add(res.R, opt.SetInt64(int64(digits[idx])))
+	}
+	return res
+}
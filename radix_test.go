@@ -0,0 +1,82 @@
+// MIT License
+//
+// Copyright (c) 2022 Tommy TIAN
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package complex
+
+import (
+	"math/big"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestGaussianInt_DigitsBaseIminus1(t *testing.T) {
+	tests := []struct {
+		name string
+		g    *GaussianInt
+		want []int
+	}{
+		{
+			name: "test_0",
+			g:    NewGaussianInt(big.NewInt(0), big.NewInt(0)),
+			want: nil,
+		},
+		{
+			name: "test_1",
+			g:    NewGaussianInt(big.NewInt(1), big.NewInt(0)),
+			want: []int{1},
+		},
+		{
+			name: "test_2",
+			g:    NewGaussianInt(big.NewInt(2), big.NewInt(0)),
+			want: []int{0, 0, 1, 1},
+		},
+		{
+			name: "test_i",
+			g:    NewGaussianInt(big.NewInt(0), big.NewInt(1)),
+			want: []int{1, 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.g.DigitsBaseIminus1(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DigitsBaseIminus1() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFromDigitsBaseIminus1(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for n := 0; n < 1000; n++ {
+		g := NewGaussianInt(big.NewInt(r.Int63n(20001)-10000), big.NewInt(r.Int63n(20001)-10000))
+		digits := g.DigitsBaseIminus1()
+		for _, d := range digits {
+			if d != 0 && d != 1 {
+				t.Fatalf("DigitsBaseIminus1(%v) = %v, contains non-binary digit", g, digits)
+			}
+		}
+		if got := FromDigitsBaseIminus1(digits); !got.Equals(g) {
+			t.Fatalf("FromDigitsBaseIminus1(%v) = %v, want %v", digits, got, g)
+		}
+	}
+}
@@ -24,13 +24,40 @@ package complex
 
 import "math/big"
 
-// roundFloat rounds the given big float to the nearest big integer
-func roundFloat(f *big.Float) *big.Int {
-	if f.Sign() < 0 {
-		f.Sub(f, rDelta)
-	} else {
-		f.Add(f, rDelta)
+// RoundFloat returns the big integer nearest to the given big float, rounding ties toward zero,
+// e.g. 2.5 and -2.5 round to 2 and -2, without modifying the big float
+// The value of f is converted to an exact rational number and rounded by roundQuo, the rule used by Div,
+// so the result does not depend on the precision of f
+// f must be finite
+func RoundFloat(f *big.Float) *big.Int {
+	rat, _ := f.Rat(nil)
+	return roundQuo(rat.Num(), rat.Denom())
+}
+
+// roundQuo returns the integer nearest to num / den for a positive den, rounding ties toward zero,
+// i.e. sign(num) * floor((2 * |num| + den - 1) / (2 * den))
+func roundQuo(num, den *big.Int) *big.Int {
+	res := new(big.Int).Abs(num)
+	res.Lsh(res, 1)
+	res.Add(res, den)
+	res.Sub(res, big1)
+	dblDen := iPool.Get().(*big.Int).Lsh(den, 1)
+	defer iPool.Put(dblDen)
+	res.Quo(res, dblDen)
+	if num.Sign() < 0 {
+		res.Neg(res)
 	}
-	res, _ := f.Int(nil)
 	return res
 }
+
+// RoundComplex128 returns the Gaussian integer nearest to the given complex number
+// The real and imaginary parts are rounded independently by RoundFloat, with ties toward zero
+// The real and imaginary parts of c must be finite
+func RoundComplex128(c complex128) *GaussianInt {
+	r := RoundFloat(new(big.Float).SetFloat64(real(c)))
+	i := RoundFloat(new(big.Float).SetFloat64(imag(c)))
+	return &GaussianInt{
+		R: r,
+		I: i,
+	}
+}
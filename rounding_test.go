@@ -0,0 +1,137 @@
+// MIT License
+//
+// Copyright (c) 2022 Tommy TIAN
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package complex
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestRoundComplex128(t *testing.T) {
+	tests := []struct {
+		name string
+		c    complex128
+		want *GaussianInt
+	}{
+		{
+			name: "test_0",
+			c:    0,
+			want: NewGaussianInt(big.NewInt(0), big.NewInt(0)),
+		},
+		{
+			name: "test_1.4-2.6i",
+			c:    complex(1.4, -2.6),
+			want: NewGaussianInt(big.NewInt(1), big.NewInt(-3)),
+		},
+		{
+			name: "test_0.5-0.5i",
+			c:    complex(0.5, -0.5),
+			want: NewGaussianInt(big.NewInt(0), big.NewInt(0)),
+		},
+		{
+			name: "test_0.505-0.505i",
+			c:    complex(0.505, -0.505),
+			want: NewGaussianInt(big.NewInt(1), big.NewInt(-1)),
+		},
+		{
+			name: "test_2.5-3.5i",
+			c:    complex(2.5, -3.5),
+			want: NewGaussianInt(big.NewInt(2), big.NewInt(-3)),
+		},
+		{
+			name: "test_-7.51+3.99i",
+			c:    complex(-7.51, 3.99),
+			want: NewGaussianInt(big.NewInt(-8), big.NewInt(4)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RoundComplex128(tt.c); !got.Equals(tt.want) {
+				t.Errorf("RoundComplex128() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRoundFloat(t *testing.T) {
+	tests := []struct {
+		f    float64
+		want int64
+	}{
+		{0, 0},
+		{0.49, 0},
+		{0.5, 0},
+		{-0.5, 0},
+		// the float64 nearest to 0.505 is slightly larger than 0.505
+		{0.505, 1},
+		{-0.505, -1},
+		{0.52, 1},
+		{-0.52, -1},
+		{1.5, 1},
+		{-1.5, -1},
+		{2.5, 2},
+		{-2.5, -2},
+		{2.75, 3},
+		{-7.25, -7},
+		// ties round toward zero regardless of the precision
+		{1e15 + 0.5, 1e15},
+		{-1e15 - 0.5, -1e15},
+		{1e15 + 0.75, 1e15 + 1},
+	}
+	for _, tt := range tests {
+		f := new(big.Float).SetFloat64(tt.f)
+		got := RoundFloat(f)
+		if got.Int64() != tt.want {
+			t.Errorf("RoundFloat(%v) = %v, want %v", tt.f, got, tt.want)
+		}
+		if f.Cmp(big.NewFloat(tt.f)) != 0 {
+			t.Errorf("RoundFloat(%v) modified its argument to %v", tt.f, f)
+		}
+	}
+	// a half-integer with a large integer part in a high precision float
+	f, _ := new(big.Float).SetPrec(200).SetString("123456789012345678901234567890.5")
+	want, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	if got := RoundFloat(f); got.Cmp(want) != 0 {
+		t.Errorf("RoundFloat(%v) = %v, want %v", f, got, want)
+	}
+	// slightly more than a half is rounded away from zero in any precision
+	for _, prec := range []uint{64, 200} {
+		f, _ := new(big.Float).SetPrec(prec).SetString("2.50000000000000001")
+		if got := RoundFloat(f); f.Cmp(big.NewFloat(2.5)) > 0 && got.Int64() != 3 {
+			t.Errorf("RoundFloat(%v) = %v, want 3 at precision %d", f, got, prec)
+		}
+	}
+}
+
+func TestRoundFloat_MatchesDiv(t *testing.T) {
+	// Div rounds the exact quotient with the same rule as RoundFloat
+	for num := int64(-40); num <= 40; num++ {
+		for den := int64(1); den <= 8; den++ {
+			quo := new(GaussianInt).Div(NewGaussianInt(big.NewInt(num), big0), NewGaussianInt(big.NewInt(den), big0))
+			want := RoundFloat(new(big.Float).SetRat(big.NewRat(num, den)))
+			if quo.R.Cmp(want) != 0 {
+				t.Fatalf("Div(%d, %d) = %v, RoundFloat(%d/%d) = %v", num, den, quo, num, den, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,96 @@
+// MIT License
+//
+// Copyright (c) 2022 Tommy TIAN
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package complex
+
+import (
+	"math/big"
+	"sort"
+)
+
+// ByNorm implements sort.Interface for a slice of Gaussian integers ordered by norm
+// Gaussian integers with equal norms are ordered by Cmp
+type ByNorm []*GaussianInt
+
+func (b ByNorm) Len() int {
+	return len(b)
+}
+
+func (b ByNorm) Less(i, j int) bool {
+	return b[i].Cmp(b[j]) < 0
+}
+
+func (b ByNorm) Swap(i, j int) {
+	b[i], b[j] = b[j], b[i]
+}
+
+// SortByNorm sorts the Gaussian integers in ascending order of their norms
+func SortByNorm(xs []*GaussianInt) {
+	sort.Sort(ByNorm(xs))
+}
+
+// PrecomputeNorms returns the norms of the Gaussian integers in the same order,
+// to be passed to SortByPrecomputedNorm
+func PrecomputeNorms(xs []*GaussianInt) []*big.Int {
+	norms := make([]*big.Int, len(xs))
+	for i, x := range xs {
+		norms[i] = x.Norm()
+	}
+	return norms
+}
+
+// byPrecomputedNorm implements sort.Interface like ByNorm, but compares the cached norms
+// instead of computing them in every comparison
+type byPrecomputedNorm struct {
+	xs    []*GaussianInt
+	norms []*big.Int
+}
+
+func (b byPrecomputedNorm) Len() int {
+	return len(b.xs)
+}
+
+func (b byPrecomputedNorm) Less(i, j int) bool {
+	if c := b.norms[i].Cmp(b.norms[j]); c != 0 {
+		return c < 0
+	}
+	if c := b.xs[i].R.Cmp(b.xs[j].R); c != 0 {
+		return c < 0
+	}
+	return b.xs[i].I.Cmp(b.xs[j].I) < 0
+}
+
+func (b byPrecomputedNorm) Swap(i, j int) {
+	b.xs[i], b.xs[j] = b.xs[j], b.xs[i]
+	b.norms[i], b.norms[j] = b.norms[j], b.norms[i]
+}
+
+// SortByPrecomputedNorm sorts the Gaussian integers in the same order as SortByNorm, using the norms
+// given by PrecomputeNorms(xs) so that each norm is computed only once
+// The norms are permuted together with the Gaussian integers, so they stay aligned after sorting
+// It panics if the lengths of xs and norms differ
+func SortByPrecomputedNorm(xs []*GaussianInt, norms []*big.Int) {
+	if len(xs) != len(norms) {
+		panic("complex: SortByPrecomputedNorm called with mismatched lengths")
+	}
+	sort.Sort(byPrecomputedNorm{xs: xs, norms: norms})
+}
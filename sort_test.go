@@ -0,0 +1,79 @@
+// MIT License
+//
+// Copyright (c) 2022 Tommy TIAN
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package complex
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func TestSortByNorm(t *testing.T) {
+	want := []*GaussianInt{
+		NewGaussianInt(big.NewInt(0), big.NewInt(0)),
+		NewGaussianInt(big.NewInt(-1), big.NewInt(0)),
+		NewGaussianInt(big.NewInt(0), big.NewInt(1)),
+		NewGaussianInt(big.NewInt(1), big.NewInt(0)),
+		NewGaussianInt(big.NewInt(1), big.NewInt(-1)),
+		NewGaussianInt(big.NewInt(1), big.NewInt(1)),
+		NewGaussianInt(big.NewInt(2), big.NewInt(1)),
+		NewGaussianInt(big.NewInt(-3), big.NewInt(2)),
+		NewGaussianInt(big.NewInt(5), big.NewInt(0)),
+	}
+	r := rand.New(rand.NewSource(1))
+	for n := 0; n < 10; n++ {
+		xs := make([]*GaussianInt, len(want))
+		copy(xs, want)
+		r.Shuffle(len(xs), func(i, j int) { xs[i], xs[j] = xs[j], xs[i] })
+		SortByNorm(xs)
+		for i := range xs {
+			if !xs[i].Equals(want[i]) {
+				t.Fatalf("SortByNorm() = %v, want %v", xs, want)
+			}
+		}
+	}
+}
+
+func TestSortByPrecomputedNorm(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for n := 0; n < 20; n++ {
+		xs := make([]*GaussianInt, 200)
+		for i := range xs {
+			// small parts give many ties in norm
+			xs[i] = NewGaussianInt(big.NewInt(r.Int63n(21)-10), big.NewInt(r.Int63n(21)-10))
+		}
+		want := make([]*GaussianInt, len(xs))
+		copy(want, xs)
+		SortByNorm(want)
+		norms := PrecomputeNorms(xs)
+		SortByPrecomputedNorm(xs, norms)
+		for i := range xs {
+			if !xs[i].Equals(want[i]) {
+				t.Fatalf("SortByPrecomputedNorm() = %v, want %v", xs, want)
+			}
+			if norms[i].Cmp(xs[i].Norm()) != 0 {
+				t.Fatalf("SortByPrecomputedNorm() norms[%d] = %v, want %v", i, norms[i], xs[i].Norm())
+			}
+		}
+	}
+}